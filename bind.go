@@ -9,13 +9,15 @@ import (
 	"sync"
 )
 
-const (
-	// maxRecursionDepth - 바인딩 시 최대 재귀 깊이
-	// 무한 재귀로 인한 스택 오버플로우를 방지합니다.
-	// maxRecursionDepth - The maximum recursion depth for binding.
-	// Prevents stack overflow from infinite recursion.
-	maxRecursionDepth = 1000
-)
+// ErrMaxDepthExceeded - 최대 재귀 깊이를 초과했을 때 반환되는 에러
+// 실제 에러는 제한에 도달한 필드 경로를 담은 BindError로 래핑됩니다.
+// ErrMaxDepthExceeded - Returned when the maximum recursion depth is exceeded.
+// The actual error is wrapped in a BindError carrying the field path where the limit was hit.
+var ErrMaxDepthExceeded = errors.New("max recursion depth exceeded")
+
+// ErrPointerCycle - 바인딩 중 자기 자신을 참조하는 포인터 순환이 감지되었을 때 반환되는 에러
+// ErrPointerCycle - Returned when a self-referencing pointer cycle is detected during binding.
+var ErrPointerCycle = errors.New("pointer cycle detected")
 
 // Binder - 바인딩 인터페이스
 // 구조체 또는 필드가 요청(r)을 기반으로 추가적인 바인딩 로직을 수행할 수 있도록 합니다.
@@ -43,37 +45,64 @@ var binderCache = &sync.Map{}
 // 1. 등록된 디코더를 사용하여 요청 본문을 'v'에 디코딩합니다.
 // 2. 'v' 내부의 모든 Binder 필드를 재귀적으로 바인딩합니다. (바텀업 순서)
 // 3. 마지막으로 'v' 자체의 Bind 메서드를 호출합니다.
+// opts는 이 호출에만 적용됩니다.
 // Action - Executes the request binding.
 // 1. Decodes the request body into 'v' using the registered decoder.
 // 2. Recursively binds all Binder fields within 'v' (in bottom-up order).
 // 3. Finally, calls the Bind method on 'v' itself.
-func Action(r *http.Request, v Binder) error {
-	if err := getDecode()(r, v); err != nil {
-		return BindError{Err: err}
-	}
-	// 최상위 호출이므로 parentField는 비워두고, depth는 0에서 시작합니다.
-	return binder(r, reflect.ValueOf(v), "", 0)
+// opts apply to this call only.
+func Action(r *http.Request, v Binder, opts ...Option) error {
+	return defaultEngine.Action(r, v, opts...)
+}
+
+// binding - 단일 바인딩 호출의 재귀 상태
+// 깊이 제한과 현재 경로 상에서 방문 중인 포인터를 추적합니다.
+// binding - The recursion state of a single binding call.
+// Tracks the depth limit and the pointers being visited along the current path.
+type binding struct {
+	r        *http.Request
+	maxDepth int
+	visiting map[visit]struct{}
 }
 
-// binder - 재귀적 바인딩 함수 (필드 경로 및 깊이 추적 기능 추가)
+// visit - 순환 감지를 위한 포인터 식별자
+// 구조체와 그 첫 번째 필드는 주소가 같을 수 있으므로 타입도 함께 비교합니다.
+// visit - A pointer identity used for cycle detection.
+// A struct and its first field may share an address, so the type is compared as well.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// bind - 재귀적 바인딩 함수 (필드 경로 및 깊이 추적 기능 추가)
 // Bind 호출 순서:
 // 1. 가장 깊은 중첩 수준의 필드부터 시작 (바텀업)
 // 2. 점차 상위 레벨로 이동
 // 3. 최종적으로 루트 구조체의 Bind 메서드 호출
-// binder - A recursive binding function (with field path and depth tracking).
+// bind - A recursive binding function (with field path and depth tracking).
 // Bind call order:
 // 1. Starts from the most deeply nested fields (bottom-up).
 // 2. Gradually moves to higher levels.
 // 3. Finally, calls the Bind method of the root struct.
-func binder(r *http.Request, rv reflect.Value, parentField string, depth int) error {
-	if depth > maxRecursionDepth {
-		return fmt.Errorf("max recursion depth (%d) exceeded", maxRecursionDepth)
+func (b *binding) bind(rv reflect.Value, parentField string, depth int) error {
+	if b.maxDepth > 0 && depth > b.maxDepth {
+		return BindError{Field: parentField, Err: fmt.Errorf("%w (limit %d)", ErrMaxDepthExceeded, b.maxDepth)}
 	}
 
 	if rv.Kind() == reflect.Ptr {
 		if rv.IsNil() {
 			return nil
 		}
+		// 현재 경로 상에 이미 있는 포인터를 다시 만나면 순환이므로 즉시 실패합니다.
+		key := visit{ptr: rv.Pointer(), typ: rv.Type()}
+		if _, ok := b.visiting[key]; ok {
+			return BindError{Field: parentField, Err: ErrPointerCycle}
+		}
+		if b.visiting == nil {
+			b.visiting = make(map[visit]struct{})
+		}
+		b.visiting[key] = struct{}{}
+		defer delete(b.visiting, key)
 		rv = rv.Elem()
 	}
 
@@ -82,7 +111,7 @@ func binder(r *http.Request, rv reflect.Value, parentField string, depth int) er
 	}
 
 	if rv.Kind() != reflect.Struct {
-		if err := rv.Addr().Interface().(Binder).Bind(r); err != nil {
+		if err := rv.Addr().Interface().(Binder).Bind(b.r); err != nil {
 			return BindError{Field: parentField, Err: err}
 		}
 		return nil
@@ -111,7 +140,7 @@ func binder(r *http.Request, rv reflect.Value, parentField string, depth int) er
 			fullPath = parentField + "." + fieldName
 		}
 
-		if err := binder(r, field, fullPath, depth+1); err != nil {
+		if err := b.bind(field, fullPath, depth+1); err != nil {
 			var bindErr BindError
 			if errors.As(err, &bindErr) {
 				return err // 이미 BindError이므로 그대로 반환
@@ -121,7 +150,7 @@ func binder(r *http.Request, rv reflect.Value, parentField string, depth int) er
 		}
 	}
 
-	if err := rv.Addr().Interface().(Binder).Bind(r); err != nil {
+	if err := rv.Addr().Interface().(Binder).Bind(b.r); err != nil {
 		return BindError{Field: parentField, Err: err}
 	}
 	return nil
@@ -181,15 +210,23 @@ func SetDecode(fn func(*http.Request, any) error) {
 
 // BindError - 표준 바인딩 에러 구조체
 // 바인딩 실패 시 어떤 필드에서 에러가 발생했는지에 대한 추가 정보를 포함할 수 있습니다.
+// Line은 Stream으로 읽은 레코드의 줄 번호(1부터 시작)이며, 그 외에는 0입니다.
 // BindError - A standard binding error struct.
 // Can include additional information about which field caused the binding failure.
+// Line is the line number (starting at 1) of a record read with Stream, and 0 otherwise.
 type BindError struct {
 	Field string
 	Err   error
+	Line  int
 }
 
 func (e BindError) Error() string {
-	if e.Field != "" {
+	switch {
+	case e.Line > 0 && e.Field != "":
+		return fmt.Sprintf("bind failed on line %d, field '%s': %v", e.Line, e.Field, e.Err)
+	case e.Line > 0:
+		return fmt.Sprintf("bind failed on line %d: %v", e.Line, e.Err)
+	case e.Field != "":
 		return fmt.Sprintf("bind failed on field '%s': %v", e.Field, e.Err)
 	}
 	return fmt.Sprintf("bind failed: %v", e.Err)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"mime/multipart"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"testing"
@@ -130,7 +131,7 @@ func TestAction_NestedBinding(t *testing.T) {
 
 func TestAction_UnsupportedContentType(t *testing.T) {
 	req, _ := http.NewRequest("POST", "/", strings.NewReader("data"))
-	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Type", "application/vnd.example")
 	err := bind.Action(req, &TestPayload{})
 	if err == nil {
 		t.Error("expected error for unsupported content type, got nil")
@@ -230,8 +231,49 @@ func TestAction_RecursionDepthLimit(t *testing.T) {
 	req, _ := http.NewRequest("POST", "/", strings.NewReader(jsonBody))
 	req.Header.Set("Content-Type", "application/json")
 	err := bind.Action(req, &DeepBinder{})
-	if err == nil || !strings.Contains(err.Error(), "max recursion depth (1000) exceeded") {
-		t.Errorf("Expected recursion depth error, got: %v", err)
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Fatalf("Expected recursion depth error, got: %v", err)
+	}
+	var bindErr bind.BindError
+	if !errors.As(err, &bindErr) || bindErr.Field != strings.TrimSuffix(strings.Repeat("Child.", 1001), ".") {
+		t.Errorf("expected error path at depth 1001, got %q", bindErr.Field)
+	}
+}
+
+func TestAction_RecursionDepthOption(t *testing.T) {
+	jsonBody := `{"child":{"child":{"child":null}}}`
+	newReq := func() *http.Request {
+		req, _ := http.NewRequest("POST", "/", strings.NewReader(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	if err := bind.Action(newReq(), &DeepBinder{}, bind.WithMaxDepth(1)); !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected per-call depth limit error, got: %v", err)
+	}
+
+	engine := bind.New(bind.WithMaxDepth(1))
+	if err := engine.Action(newReq(), &DeepBinder{}); !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected engine depth limit error, got: %v", err)
+	}
+	// 호출 옵션이 엔진 옵션보다 우선합니다.
+	if err := engine.Action(newReq(), &DeepBinder{}, bind.WithMaxDepth(10)); err != nil {
+		t.Errorf("expected call option to override engine limit, got: %v", err)
+	}
+}
+
+func TestAction_PointerCycle(t *testing.T) {
+	req, _ := http.NewRequest("POST", "/", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	payload := &DeepBinder{}
+	payload.Child = &DeepBinder{Child: payload}
+	err := bind.Action(req, payload)
+	if !errors.Is(err, bind.ErrPointerCycle) {
+		t.Fatalf("expected pointer cycle error, got: %v", err)
+	}
+	var bindErr bind.BindError
+	if !errors.As(err, &bindErr) || bindErr.Field != "Child.Child" {
+		t.Errorf("expected cycle at 'Child.Child', got %q", bindErr.Field)
 	}
 }
 
@@ -306,6 +348,8 @@ func TestGetContentType(t *testing.T) {
 		{"multipart/form-data; boundary=...", bind.ContentTypeMultipart},
 		{"text/html", bind.ContentTypeHTML},
 		{"text/event-stream", bind.ContentTypeEventStream},
+		{"multipart/mixed; boundary=batch", bind.ContentTypeMultipartMixed},
+		{"multipart/related; type=\"application/json\"", bind.ContentTypeMultipartRelated},
 		{"application/unknown", bind.ContentTypeUnknown},
 	}
 
@@ -374,3 +418,82 @@ func TestConcurrentBinding(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+// newFileUploadRequest - 단일 파일 필드를 가진 멀티파트 요청을 생성합니다.
+func newFileUploadRequest(t *testing.T, field, filename string, content []byte) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(content)
+	writer.Close()
+	req, _ := http.NewRequest("POST", "/", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+// isOnDisk - 업로드된 파일이 임시 파일로 저장되었는지 확인합니다.
+func isOnDisk(t *testing.T, fh *multipart.FileHeader) bool {
+	t.Helper()
+	f, err := fh.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	_, ok := f.(*os.File)
+	return ok
+}
+
+func TestAction_MultipartMemoryContextOverride(t *testing.T) {
+	content := bytes.Repeat([]byte("x"), 4096)
+
+	engine := bind.New(bind.WithMaxMultipartMemory(1024))
+	req := newFileUploadRequest(t, "file", "big.bin", content)
+	payload := &FileUploadPayload{}
+	if err := engine.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !isOnDisk(t, payload.File) {
+		t.Error("expected file above the engine limit to be stored on disk")
+	}
+	if req.MultipartForm == nil {
+		t.Fatal("expected parsed multipart form to remain available on the request")
+	}
+	req.MultipartForm.RemoveAll()
+
+	req = newFileUploadRequest(t, "file", "big.bin", content)
+	req = req.WithContext(bind.ContextWithOptions(req.Context(), bind.WithMaxMultipartMemory(1<<20)))
+	payload = &FileUploadPayload{}
+	if err := engine.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if isOnDisk(t, payload.File) {
+		t.Error("expected context override to keep the file in memory")
+	}
+}
+
+// TestConcurrentMultipartMemory - `go test -race`를 통해 멀티파트 메모리 설정의 동시성 안전성을 검증합니다.
+func TestConcurrentMultipartMemory(t *testing.T) {
+	original := bind.GetMaxMultipartMemory()
+	t.Cleanup(func() { bind.SetMaxMultipartMemory(original) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			bind.SetMaxMultipartMemory(int64(i+1) << 20)
+		}(i)
+		req := newFileUploadRequest(t, "file", "test.txt", []byte("test file"))
+		go func() {
+			defer wg.Done()
+			if err := bind.Action(req, &FileUploadPayload{}); err != nil {
+				t.Errorf("concurrent multipart binding failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
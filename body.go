@@ -0,0 +1,159 @@
+package bind
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrBodyTooLarge - 요청 본문이 WithMaxBodySize로 지정한 크기를 초과했을 때 반환되는 에러
+// ErrBodyTooLarge - Returned when the request body exceeds the size set with WithMaxBodySize.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// ErrUnknownField - 엄격한 디코딩에서 본문에 대상 구조체에 없는 필드가 있을 때 반환되는 에러
+// ErrUnknownField - Returned by strict decoding when the body contains a field the target struct does not have.
+var ErrUnknownField = errors.New("unknown field")
+
+// DefaultMaxNestingDepth - 본문 문서의 기본 최대 중첩 깊이
+// encoding/json이 내부적으로 허용하는 깊이와 같으므로, 이 값에서는 JSON 문서의 깊이를 따로 추적하지 않습니다.
+// DefaultMaxNestingDepth - The default maximum nesting depth of body documents.
+// Matches the depth encoding/json allows internally, so JSON documents are not tracked separately at this value.
+const DefaultMaxNestingDepth = jsonMaxDepth
+
+// jsonMaxDepth - encoding/json이 내부적으로 허용하는 최대 중첩 깊이
+// jsonMaxDepth - The maximum nesting depth encoding/json allows internally.
+const jsonMaxDepth = 10000
+
+// WithMaxNestingDepth - JSON, YAML, TOML, MessagePack, CBOR 문서의 최대 중첩 깊이를 지정합니다. 0 이하의 값은 제한을 해제합니다.
+// 제한을 넘으면 ErrMaxDepthExceeded를 반환합니다. Binder 재귀 깊이는 WithMaxDepth로 따로 제한합니다.
+// WithMaxNestingDepth - Sets the maximum nesting depth of JSON, YAML, TOML, MessagePack and CBOR documents. A value of 0 or less disables the limit.
+// Exceeding it returns ErrMaxDepthExceeded. The Binder recursion depth is limited separately by WithMaxDepth.
+func WithMaxNestingDepth(n int) Option {
+	return func(c *config) { c.maxNesting = n }
+}
+
+// WithMaxBodySize - JSON, XML, YAML, TOML, MessagePack, CBOR, 텍스트, 바이너리, 폼 본문의 최대 바이트 수를 지정합니다. 0 이하의 값은 제한을 해제합니다.
+// 멀티파트 본문에는 UploadLimits.MaxTotalSize가 적용됩니다.
+// WithMaxBodySize - Sets the maximum size in bytes of JSON, XML, YAML, TOML, MessagePack, CBOR, text, binary and form bodies. A value of 0 or less disables the limit.
+// Multipart bodies are limited by UploadLimits.MaxTotalSize instead.
+func WithMaxBodySize(size int64) Option {
+	return func(c *config) { c.maxBodySize = size }
+}
+
+// WithStrictDecoding - JSON, YAML, TOML, MessagePack, CBOR 본문에 대상 구조체에 없는 필드가 있으면 ErrUnknownField를 반환합니다.
+// WithStrictDecoding - Makes JSON, YAML, TOML, MessagePack and CBOR bodies fail with ErrUnknownField when they contain a field the target struct does not have.
+func WithStrictDecoding(enabled bool) Option {
+	return func(c *config) { c.strictDecoding = enabled }
+}
+
+// limitBody - 설정된 본문 크기 제한을 적용한 본문 reader를 반환합니다.
+// limitBody - Returns the body reader with the configured size limit applied.
+func limitBody(r *http.Request, cfg *config) io.Reader {
+	if cfg.maxBodySize > 0 {
+		r.Body = http.MaxBytesReader(nil, r.Body, cfg.maxBodySize)
+	}
+	return r.Body
+}
+
+// bodyReadError - 본문 크기 제한 초과를 ErrBodyTooLarge로 변환합니다.
+// bodyReadError - Converts an exceeded body size limit into ErrBodyTooLarge.
+func bodyReadError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, maxBytesErr.Limit)
+	}
+	return err
+}
+
+// jsonError - JSON 디코딩 에러를 필드 경로를 담은 BindError로 변환합니다.
+// jsonError - Converts a JSON decoding error into a BindError carrying the field path.
+func jsonError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return BindError{Field: typeErr.Field, Err: err}
+	}
+	if err := unknownFieldError(err); err != nil {
+		return err
+	}
+	// encoding/json은 깊이 초과를 문법 에러로 보고하며 별도의 타입을 제공하지 않아 메시지로 구분합니다.
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) && strings.Contains(syntaxErr.Error(), "exceeded max depth") {
+		return fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, jsonMaxDepth)
+	}
+	return bodyReadError(err)
+}
+
+// unknownFieldError - encoding/json, msgpack, protojson의 알 수 없는 필드 에러를 ErrUnknownField를 감싼 BindError로 변환합니다.
+// 해당하는 에러가 아니면 nil을 반환합니다. 세 라이브러리 모두 에러 타입을 제공하지 않아 메시지로 구분하며,
+// protobuf는 메시지의 공백을 무작위로 바꾸므로 구분자 뒤의 공백에 의존하지 않습니다.
+// unknownFieldError - Converts an unknown field error from encoding/json, msgpack or protojson into a BindError wrapping ErrUnknownField.
+// Returns nil for any other error. None of the libraries exposes an error type, so the message is used to tell them apart,
+// without relying on the spaces after separators, which protobuf randomizes.
+func unknownFieldError(err error) error {
+	msg := err.Error()
+	if !strings.HasPrefix(msg, "json:") && !strings.HasPrefix(msg, "msgpack:") && !strings.HasPrefix(msg, "proto:") {
+		return nil
+	}
+	_, quoted, ok := strings.Cut(msg, "unknown field ")
+	if !ok {
+		return nil
+	}
+	name, unquoteErr := strconv.Unquote(quoted)
+	if unquoteErr != nil {
+		return nil
+	}
+	return BindError{Field: name, Err: fmt.Errorf("%w %q", ErrUnknownField, name)}
+}
+
+// jsonDepthReader - 읽는 동안 JSON 문서의 중첩 깊이를 추적해, 제한을 넘으면 읽기를 중단하는 io.Reader
+// 디코더가 깊게 중첩된 문서를 끝까지 파싱하기 전에 멈추게 합니다.
+// jsonDepthReader - An io.Reader that tracks the nesting depth of a JSON document as it is read, and stops once the limit is exceeded.
+// Stops the decoder before it parses a deeply nested document to the end.
+type jsonDepthReader struct {
+	r        io.Reader
+	max      int
+	depth    int
+	inString bool
+	escaped  bool
+}
+
+// newJSONDepthReader - maxDepth가 0 이하이거나 encoding/json의 자체 제한 이상이면 r을 그대로 반환합니다.
+// 그 경우 깊이 초과는 encoding/json이 보고하고 jsonError가 ErrMaxDepthExceeded로 변환합니다.
+// newJSONDepthReader - Returns r unchanged if maxDepth is 0 or less, or at least encoding/json's own limit.
+// In that case encoding/json reports the excess depth, and jsonError converts it into ErrMaxDepthExceeded.
+func newJSONDepthReader(r io.Reader, maxDepth int) io.Reader {
+	if maxDepth <= 0 || maxDepth >= jsonMaxDepth {
+		return r
+	}
+	return &jsonDepthReader{r: r, max: maxDepth}
+}
+
+func (d *jsonDepthReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	for _, c := range p[:n] {
+		switch {
+		case d.escaped:
+			d.escaped = false
+		case d.inString:
+			if c == '\\' {
+				d.escaped = true
+			} else if c == '"' {
+				d.inString = false
+			}
+		case c == '"':
+			d.inString = true
+		case c == '{' || c == '[':
+			d.depth++
+			if d.depth > d.max {
+				return 0, fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, d.max)
+			}
+		case c == '}' || c == ']':
+			d.depth--
+		}
+	}
+	return n, err
+}
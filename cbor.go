@@ -0,0 +1,224 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+var cborUnmarshalerType = reflect.TypeOf((*cbor.Unmarshaler)(nil)).Elem()
+
+// cborRawMode - 이미 디코딩에 성공한 문서를 항목 단위로 나눌 때 쓰는 디코딩 모드. 깊이는 문서를 디코딩할 때 이미 제한되었습니다.
+// cborRawMode - The decoding mode used to split an already decoded document into items. Depth was already limited when the document was decoded.
+var cborRawMode, _ = cbor.DecOptions{MaxNestedLevels: maxCBORNesting}.DecMode()
+
+// CBOR 디코더가 허용하는 중첩 깊이 범위
+// The nesting depth range accepted by the CBOR decoder.
+const (
+	minCBORNesting = 4
+	maxCBORNesting = 65535
+)
+
+// cborModeKey - 디코딩 모드 캐시 키
+// cborModeKey - The decoding mode cache key.
+type cborModeKey struct {
+	nesting int
+	strict  bool
+}
+
+// cborModeCache - 설정별 CBOR 디코딩 모드 캐시
+// cborModeCache - A cache of CBOR decoding modes per setting.
+var cborModeCache = &sync.Map{}
+
+// cborDecMode - 설정에 맞는 CBOR 디코딩 모드를 반환합니다.
+// 태그 0(RFC 3339 문자열)과 태그 1(에포크 초)은 물론 태그가 없는 문자열과 숫자도 time.Time에 디코딩하고,
+// 태그 21~23(base64url, base64, base16 예정 인코딩)이 붙은 바이트 문자열은 해당 인코딩으로 문자열 필드에 디코딩합니다.
+// cborDecMode - Returns the CBOR decoding mode for the settings.
+// Tag 0 (RFC 3339 strings) and tag 1 (epoch seconds), as well as untagged strings and numbers, are decoded into time.Time,
+// and byte strings with tags 21 to 23 (expected base64url, base64 and base16 encoding) are decoded into string fields using that encoding.
+func cborDecMode(nesting int, strict bool) (cbor.DecMode, error) {
+	// 디코더는 [4, 65535] 범위의 깊이만 받으므로, 제한이 없으면 최댓값을, 너무 작으면 최솟값을 사용합니다.
+	if nesting <= 0 || nesting > maxCBORNesting {
+		nesting = maxCBORNesting
+	}
+	nesting = max(nesting, minCBORNesting)
+	key := cborModeKey{nesting: nesting, strict: strict}
+	if cached, ok := cborModeCache.Load(key); ok {
+		return cached.(cbor.DecMode), nil
+	}
+	opts := cbor.DecOptions{
+		TimeTag:            cbor.DecTagOptional,
+		MaxNestedLevels:    nesting,
+		ByteStringToString: cbor.ByteStringToStringAllowedWithExpectedLaterEncoding,
+	}
+	if strict {
+		opts.ExtraReturnErrors = cbor.ExtraDecErrorUnknownField
+	}
+	mode, err := opts.DecMode()
+	if err != nil {
+		return nil, err
+	}
+	cborModeCache.Store(key, mode)
+	return mode, nil
+}
+
+// decodeCBORRequest - RFC 8949 CBOR 본문을 디코딩합니다.
+// 구조체 필드는 cbor 태그, 없으면 json 태그로 찾습니다. 본문 크기 제한, 중첩 깊이 제한, 엄격한 디코딩이 JSON과 같이 적용되며,
+// 중첩 깊이는 4에서 65535 사이로 맞춰집니다.
+// decodeCBORRequest - Decodes an RFC 8949 CBOR body.
+// Struct fields are matched by the cbor tag, then the json tag. The body size limit, nesting depth limit and strict decoding apply as for JSON,
+// with the nesting depth clamped to between 4 and 65535.
+func decodeCBORRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return bodyReadError(err)
+	}
+	mode, err := cborDecMode(cfg.maxNesting, cfg.strictDecoding)
+	if err != nil {
+		return err
+	}
+	if err := mode.Unmarshal(data, v); err != nil {
+		var nestedErr *cbor.MaxNestedLevelError
+		if errors.As(err, &nestedErr) {
+			return fmt.Errorf("%w: %v", ErrMaxDepthExceeded, err)
+		}
+		var unknownErr *cbor.UnknownFieldError
+		if errors.As(err, &unknownErr) {
+			// 디코더는 맵 안의 위치만 알려 주므로 문서를 대상 타입과 함께 따라가며 키의 경로를 찾습니다.
+			if path, key, ok := findUnknownCBORField(data, reflect.TypeOf(v), ""); ok {
+				return BindError{Field: path, Err: fmt.Errorf("%w %q", ErrUnknownField, key)}
+			}
+			return BindError{Err: fmt.Errorf("%w at map element %d", ErrUnknownField, unknownErr.Index)}
+		}
+		return err
+	}
+	return nil
+}
+
+// cborFieldCache - 구조체 타입별 CBOR 필드 목록 캐시
+// cborFieldCache - A cache of CBOR fields per struct type.
+var cborFieldCache = &sync.Map{}
+
+// getCBORFields - 구조체 타입의 CBOR 필드 목록을 반환합니다. 이름은 cbor 태그, 없으면 json 태그, 둘 다 없으면 필드 이름입니다.
+// getCBORFields - Returns the CBOR fields of a struct type. The name is the cbor tag, then the json tag, then the field name.
+func getCBORFields(rt reflect.Type) jsonFields {
+	if cached, ok := cborFieldCache.Load(rt); ok {
+		return cached.(jsonFields)
+	}
+	fields := buildJSONFields(rt, []string{"cbor", "json"}, map[reflect.Type]bool{})
+	cborFieldCache.Store(rt, fields)
+	return fields
+}
+
+// findUnknownCBORField - 디코더와 같이 문서 순서대로 data를 대상 타입 rt와 함께 따라가며 처음 나오는 알 수 없는 키와 그 경로를 찾습니다.
+// findUnknownCBORField - Walks data alongside the target type rt in document order, as the decoder does, and finds the first unknown key and its path.
+func findUnknownCBORField(data []byte, rt reflect.Type, path string) (string, string, bool) {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if len(data) == 0 || reflect.PointerTo(rt).Implements(cborUnmarshalerType) {
+		return "", "", false
+	}
+	switch major := data[0] >> 5; {
+	case major == 5 && (rt.Kind() == reflect.Struct || rt.Kind() == reflect.Map):
+		found := false
+		var foundPath, foundKey string
+		eachCBORItem(data, true, func(k, v cbor.RawMessage) bool {
+			var key any
+			if cbor.Unmarshal(k, &key) != nil {
+				return false
+			}
+			name := fmt.Sprint(key)
+			elem := rt
+			if rt.Kind() == reflect.Struct {
+				f := getCBORFields(rt).lookup(name)
+				if f == nil {
+					foundPath, foundKey, found = joinPath(path, name), name, true
+					return false
+				}
+				elem = rt.FieldByIndex(f.index).Type
+			} else {
+				elem = rt.Elem()
+			}
+			foundPath, foundKey, found = findUnknownCBORField(v, elem, joinPath(path, name))
+			return !found
+		})
+		return foundPath, foundKey, found
+	case major == 4 && (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array):
+		found, i := false, 0
+		var foundPath, foundKey string
+		eachCBORItem(data, false, func(_, v cbor.RawMessage) bool {
+			foundPath, foundKey, found = findUnknownCBORField(v, rt.Elem(), path+"["+strconv.Itoa(i)+"]")
+			i++
+			return !found
+		})
+		return foundPath, foundKey, found
+	}
+	return "", "", false
+}
+
+// eachCBORItem - CBOR 배열의 요소 또는 맵의 키와 값을 순서대로 yield에 넘깁니다. yield가 false를 반환하면 멈춥니다.
+// eachCBORItem - Passes the elements of a CBOR array, or the keys and values of a map, to yield in order. Stops when yield returns false.
+func eachCBORItem(data []byte, isMap bool, yield func(key, value cbor.RawMessage) bool) {
+	count, rest, ok := cborHeader(data)
+	if !ok {
+		return
+	}
+	next := func() (cbor.RawMessage, bool) {
+		var item cbor.RawMessage
+		var err error
+		rest, err = cborRawMode.UnmarshalFirst(rest, &item)
+		return item, err == nil
+	}
+	// count가 -1이면 길이가 정해지지 않은 항목으로, break 바이트(0xff)에서 끝납니다.
+	for i := 0; count < 0 || i < count; i++ {
+		if count < 0 && (len(rest) == 0 || rest[0] == 0xff) {
+			return
+		}
+		var key cbor.RawMessage
+		if isMap {
+			if key, ok = next(); !ok {
+				return
+			}
+		}
+		value, ok := next()
+		if !ok || !yield(key, value) {
+			return
+		}
+	}
+}
+
+// cborHeader - 배열 또는 맵의 머리에서 항목 수와 나머지 바이트를 읽습니다. 길이가 정해지지 않았으면 항목 수는 -1입니다.
+// cborHeader - Reads the item count and the remaining bytes from the head of an array or map. The count is -1 for indefinite length.
+func cborHeader(data []byte) (int, []byte, bool) {
+	info := data[0] & 0x1f
+	switch {
+	case info < 24:
+		return int(info), data[1:], true
+	case info == 31:
+		return -1, data[1:], true
+	case info > 27:
+		return 0, nil, false
+	}
+	size := 1 << (info - 24)
+	if len(data) < 1+size {
+		return 0, nil, false
+	}
+	var n uint64
+	for _, b := range data[1 : 1+size] {
+		n = n<<8 | uint64(b)
+	}
+	if n > uint64(len(data)) {
+		return 0, nil, false
+	}
+	return int(n), data[1+size:], true
+}
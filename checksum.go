@@ -0,0 +1,218 @@
+package bind
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"reflect"
+	"strings"
+)
+
+var (
+	// ErrChecksumMismatch - 업로드된 파일의 해시가 클라이언트가 보낸 다이제스트와 다를 때 반환되는 에러
+	// ErrChecksumMismatch - Returned when the hash of an uploaded file differs from the digest sent by the client.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrChecksumMissing - checksum 옵션이 지정된 파일에 비교할 다이제스트가 없을 때 반환되는 에러
+	// ErrChecksumMissing - Returned when a file with the checksum option comes without a digest to compare against.
+	ErrChecksumMissing = errors.New("checksum missing")
+	// ErrInvalidChecksum - 클라이언트가 보낸 다이제스트가 알고리즘에 맞는 16진수나 base64 값이 아닐 때 반환되는 에러
+	// ErrInvalidChecksum - Returned when the digest sent by the client is not a hex or base64 value of the algorithm's size.
+	ErrInvalidChecksum = errors.New("invalid checksum")
+)
+
+// checksumAlgorithm - `file:"checksum=..."` 태그로 지정할 수 있는 해시 알고리즘
+// digestNames는 Digest, Content-Digest, Repr-Digest 헤더에서 쓰이는 알고리즘 이름입니다.
+// checksumAlgorithm - A hash algorithm that can be named in a `file:"checksum=..."` tag.
+// digestNames are the algorithm names used in the Digest, Content-Digest and Repr-Digest headers.
+type checksumAlgorithm struct {
+	new         func() hash.Hash
+	digestNames []string
+}
+
+var checksumAlgorithms = map[string]checksumAlgorithm{
+	"md5":    {md5.New, []string{"md5"}},
+	"sha1":   {sha1.New, []string{"sha", "sha-1"}},
+	"sha256": {sha256.New, []string{"sha-256"}},
+	"sha512": {sha512.New, []string{"sha-512"}},
+}
+
+// checksum - 파일 내용의 해시를 계산해 기대하는 다이제스트와 비교하는 io.Writer
+// checksum - An io.Writer that hashes file content and compares it against the expected digest.
+type checksum struct {
+	algorithm string
+	filename  string
+	h         hash.Hash
+	want      []byte
+	// sum - verify가 성공한 뒤의 16진수 다이제스트
+	// sum - The hex digest, set once verify succeeds.
+	sum string
+}
+
+func (c *checksum) Write(p []byte) (int, error) { return c.h.Write(p) }
+
+// verify - 계산한 해시를 기대하는 다이제스트와 비교합니다.
+// verify - Compares the computed hash against the expected digest.
+func (c *checksum) verify() error {
+	sum := c.h.Sum(nil)
+	if subtle.ConstantTimeCompare(sum, c.want) != 1 {
+		return fmt.Errorf("%w: %s of %q is %x", ErrChecksumMismatch, c.algorithm, c.filename, sum)
+	}
+	c.sum = hex.EncodeToString(sum)
+	return nil
+}
+
+// newChecksum - 필드 옵션에 따라 파일의 기대 다이제스트를 찾아 checksum을 만듭니다.
+// checksumfield가 지정되면 같은 위치의 형제 폼 필드 값 중 i번째를, 아니면 파트의 Content-MD5, Digest,
+// Content-Digest, Repr-Digest 헤더를 사용합니다. checksum 옵션이 없으면 nil을 반환합니다.
+// newChecksum - Looks up the expected digest of a file according to the field options and creates a checksum.
+// With checksumfield, the i-th value of the sibling form field is used; otherwise the part's Content-MD5, Digest,
+// Content-Digest and Repr-Digest headers are. Returns nil if the checksum option is not set.
+func newChecksum(opts fileOptions, filename string, header textproto.MIMEHeader, siblings []string, i int) (*checksum, error) {
+	if opts.checksum == "" {
+		return nil, nil
+	}
+	alg := checksumAlgorithms[opts.checksum]
+	c := &checksum{algorithm: opts.checksum, filename: filename, h: alg.new()}
+	var expected string
+	source := "part headers"
+	if opts.checksumField != "" {
+		if i < len(siblings) {
+			expected = siblings[i]
+		}
+		source = fmt.Sprintf("field %q", opts.checksumField)
+	} else {
+		expected = headerDigest(header, opts.checksum, alg.digestNames)
+	}
+	if expected == "" {
+		return nil, fmt.Errorf("%w: no %s digest for %q", ErrChecksumMissing, opts.checksum, filename)
+	}
+	want, ok := decodeDigest(expected, c.h.Size())
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed %s digest %q in %s for %q", ErrInvalidChecksum, opts.checksum, expected, source, filename)
+	}
+	c.want = want
+	return c, nil
+}
+
+// headerDigest - 파트 헤더에서 알고리즘에 해당하는 다이제스트를 찾습니다.
+// headerDigest - Finds the digest for the algorithm in the part headers.
+func headerDigest(header textproto.MIMEHeader, algorithm string, names []string) string {
+	if algorithm == "md5" {
+		if v := header.Get("Content-MD5"); v != "" {
+			return v
+		}
+	}
+	for _, key := range []string{"Content-Digest", "Repr-Digest", "Digest"} {
+		for _, line := range header.Values(key) {
+			for _, item := range strings.Split(line, ",") {
+				name, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+				if ok && containsFold(names, name) {
+					// Content-Digest와 Repr-Digest는 값을 ':'로 감쌉니다 (RFC 9530).
+					return strings.Trim(value, ":")
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// containsFold - 대소문자를 구분하지 않고 names에 name이 있는지 확인합니다.
+// containsFold - Reports whether name is in names, ignoring case.
+func containsFold(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeDigest - 16진수 또는 base64로 인코딩된 다이제스트를 디코딩합니다.
+// decodeDigest - Decodes a digest encoded in hex or base64.
+func decodeDigest(s string, size int) ([]byte, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) == hex.EncodedLen(size) {
+		if b, err := hex.DecodeString(s); err == nil {
+			return b, true
+		}
+	}
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if b, err := enc.DecodeString(s); err == nil && len(b) == size {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// siblingKey - 파일 키와 같은 구조체 안에 있는 형제 필드의 폼 키를 반환합니다.
+// 예: "documents[0].file"과 "sha256"이면 "documents[0].sha256"
+// siblingKey - Returns the form key of a sibling field within the same struct as the file key.
+// e.g. "documents[0].file" and "sha256" give "documents[0].sha256".
+func siblingKey(key, name string) string {
+	if i := strings.LastIndexByte(key, '.'); i >= 0 {
+		return key[:i+1] + name
+	}
+	return name
+}
+
+// verifyChecksums - 파싱된 업로드 파일들의 해시를 계산해 기대 다이제스트와 비교하고, 16진수 다이제스트를 반환합니다.
+// verifyChecksums - Hashes parsed uploaded files, compares them against the expected digests and returns the hex digests.
+func verifyChecksums(form *multipart.Form, key string, files []*multipart.FileHeader, opts fileOptions) ([]string, error) {
+	if opts.checksum == "" {
+		return nil, nil
+	}
+	siblings := form.Value[siblingKey(key, opts.checksumField)]
+	sums := make([]string, len(files))
+	for i, fh := range files {
+		c, err := newChecksum(opts, fh.Filename, fh.Header, siblings, i)
+		if err != nil {
+			return nil, err
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(c, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if err := c.verify(); err != nil {
+			return nil, err
+		}
+		sums[i] = c.sum
+	}
+	return sums, nil
+}
+
+// setChecksums - 바인딩된 *File, *StoredFile 값에 계산한 다이제스트를 설정합니다.
+// setChecksums - Sets the computed digests on bound *File and *StoredFile values.
+func setChecksums(field reflect.Value, sums []string) {
+	if len(sums) == 0 {
+		return
+	}
+	switch v := field.Interface().(type) {
+	case *File:
+		v.Checksum = sums[0]
+	case []*File:
+		for i, f := range v {
+			f.Checksum = sums[i]
+		}
+	case *StoredFile:
+		v.Checksum = sums[0]
+	case []*StoredFile:
+		for i, f := range v {
+			f.Checksum = sums[i]
+		}
+	}
+}
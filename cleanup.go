@@ -0,0 +1,84 @@
+package bind
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// Cleanup - 바인딩 중 만들어진 임시 자원(멀티파트 임시 파일 등)을 정리하는 함수
+// 여러 번 호출해도 안전하며, 두 번째 호출부터는 아무것도 하지 않습니다.
+// Cleanup - A function that releases temporary resources created during binding (such as multipart temp files).
+// Safe to call more than once; calls after the first do nothing.
+type Cleanup func() error
+
+// WithAutoCleanup - 요청 컨텍스트가 끝날 때 바인딩 중 만들어진 임시 자원을 자동으로 정리하도록 합니다.
+// 서버 요청의 컨텍스트는 핸들러가 반환되면 취소되므로, 핸들러가 RemoveAll 호출을 잊어도 임시 파일이 남지 않습니다.
+// WithAutoCleanup - Automatically releases temporary resources created during binding when the request context ends.
+// Server request contexts are cancelled once the handler returns, so temp files do not linger even if handlers forget RemoveAll.
+func WithAutoCleanup(enabled bool) Option {
+	return func(c *config) { c.autoCleanup = enabled }
+}
+
+// ActionWithCleanup - Action과 같지만, 임시 자원을 정리하는 Cleanup을 함께 반환합니다.
+// 에러가 반환되어도 Cleanup은 nil이 아니므로 바로 defer로 등록할 수 있습니다.
+// ActionWithCleanup - Like Action, but also returns a Cleanup that releases temporary resources.
+// The Cleanup is never nil, even when an error is returned, so it can be deferred right away.
+func ActionWithCleanup(r *http.Request, v Binder, opts ...Option) (Cleanup, error) {
+	return defaultEngine.ActionWithCleanup(r, v, opts...)
+}
+
+// ActionWithCleanup - 엔진 설정으로 Action을 수행하고 Cleanup을 반환합니다.
+// ActionWithCleanup - Performs Action with the engine settings and returns a Cleanup.
+func (e *Engine) ActionWithCleanup(r *http.Request, v Binder, opts ...Option) (Cleanup, error) {
+	cfg, err := e.action(r, v, opts)
+	return cfg.cleanup.run, err
+}
+
+// registerCleanup - 설정에 따라 요청 컨텍스트가 끝날 때 정리 작업이 실행되도록 등록합니다.
+// registerCleanup - Registers the cleanup list to run when the request context ends, if configured.
+func registerCleanup(ctx context.Context, cfg *config) {
+	if cfg.autoCleanup {
+		context.AfterFunc(ctx, func() { cfg.cleanup.run() })
+	}
+}
+
+// cleanupList - 나중에 실행할 정리 작업 목록
+// 바인딩 실패 시의 롤백과 요청 종료 시의 정리에 모두 사용됩니다.
+// cleanupList - A list of cleanup actions to run later.
+// Used both for rollback on binding failure and for cleanup at the end of the request.
+type cleanupList struct {
+	mu  sync.Mutex
+	fns []func() error
+}
+
+// add - 정리 작업을 등록합니다. nil 수신자에서는 아무것도 하지 않습니다.
+// add - Registers a cleanup action. Does nothing on a nil receiver.
+func (l *cleanupList) add(fn func() error) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fns = append(l.fns, fn)
+}
+
+// run - 등록된 작업을 역순으로 한 번만 실행하고, 발생한 에러를 모아 반환합니다.
+// run - Runs the registered actions once, in reverse order, and returns the collected errors.
+func (l *cleanupList) run() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	fns := l.fns
+	l.fns = nil
+	l.mu.Unlock()
+	var errs []error
+	for i := len(fns) - 1; i >= 0; i-- {
+		if err := fns[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
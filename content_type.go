@@ -2,6 +2,7 @@ package bind
 
 import (
 	"strings"
+	"sync"
 )
 
 // ContentType - HTTP Content-Type을 나타내는 열거형
@@ -33,14 +34,71 @@ const (
 	// ContentTypeEventStream - "text/event-stream"
 	// ContentTypeEventStream - "text/event-stream".
 	ContentTypeEventStream
+	// ContentTypeMultipartMixed - "multipart/mixed"
+	// ContentTypeMultipartMixed - "multipart/mixed".
+	ContentTypeMultipartMixed
+	// ContentTypeMultipartRelated - "multipart/related"
+	// ContentTypeMultipartRelated - "multipart/related".
+	ContentTypeMultipartRelated
+	// ContentTypeYAML - "application/yaml"
+	// ContentTypeYAML - "application/yaml".
+	ContentTypeYAML
+	// ContentTypeTOML - "application/toml"
+	// ContentTypeTOML - "application/toml".
+	ContentTypeTOML
+	// ContentTypeMsgPack - "application/msgpack"
+	// ContentTypeMsgPack - "application/msgpack".
+	ContentTypeMsgPack
+	// ContentTypeCBOR - "application/cbor"
+	// ContentTypeCBOR - "application/cbor".
+	ContentTypeCBOR
+	// ContentTypeProtobuf - "application/x-protobuf"
+	// ContentTypeProtobuf - "application/x-protobuf".
+	ContentTypeProtobuf
+	// ContentTypeNDJSON - "application/x-ndjson"
+	// ContentTypeNDJSON - "application/x-ndjson".
+	ContentTypeNDJSON
+	// ContentTypeCSV - "text/csv"
+	// ContentTypeCSV - "text/csv".
+	ContentTypeCSV
+	// ContentTypeOctetStream - "application/octet-stream"
+	// ContentTypeOctetStream - "application/octet-stream".
+	ContentTypeOctetStream
+	// ContentTypeMergePatch - "application/merge-patch+json"
+	// ContentTypeMergePatch - "application/merge-patch+json".
+	ContentTypeMergePatch
+	// ContentTypeJSONPatch - "application/json-patch+json"
+	// ContentTypeJSONPatch - "application/json-patch+json".
+	ContentTypeJSONPatch
 )
 
+var (
+	contentTypeMu sync.RWMutex
+	contentTypes  = map[string]ContentType{}
+)
+
+// RegisterContentType - 미디어 타입을 ContentType에 연결합니다.
+// RegisterDecoder로 등록한 커스텀 디코더를 새 미디어 타입에 사용할 때 함께 호출합니다. 등록된 연결은 기본 연결보다 우선합니다.
+// RegisterContentType - Maps a media type to a ContentType.
+// Call it together with RegisterDecoder to use a custom decoder for a new media type. Registered mappings take precedence over the built-in ones.
+func RegisterContentType(mediaType string, ct ContentType) {
+	contentTypeMu.Lock()
+	defer contentTypeMu.Unlock()
+	contentTypes[strings.ToLower(strings.TrimSpace(mediaType))] = ct
+}
+
 // GetContentType - Content-Type 문자열을 파싱하여 ContentType 열거형 값으로 변환합니다.
 // "; charset=..."과 같은 추가 파라미터는 무시합니다.
 // GetContentType - Parses a Content-Type string and converts it to a ContentType enum value.
 // It ignores additional parameters like "; charset=...".
 func GetContentType(s string) ContentType {
 	s = strings.TrimSpace(strings.Split(s, ";")[0])
+	contentTypeMu.RLock()
+	ct, ok := contentTypes[strings.ToLower(s)]
+	contentTypeMu.RUnlock()
+	if ok {
+		return ct
+	}
 	switch s {
 	case "text/plain":
 		return ContentTypePlainText
@@ -56,6 +114,30 @@ func GetContentType(s string) ContentType {
 		return ContentTypeMultipart
 	case "text/event-stream":
 		return ContentTypeEventStream
+	case "multipart/mixed":
+		return ContentTypeMultipartMixed
+	case "multipart/related":
+		return ContentTypeMultipartRelated
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return ContentTypeYAML
+	case "application/toml":
+		return ContentTypeTOML
+	case "application/msgpack", "application/x-msgpack", "application/vnd.msgpack":
+		return ContentTypeMsgPack
+	case "application/cbor":
+		return ContentTypeCBOR
+	case "application/x-protobuf", "application/protobuf":
+		return ContentTypeProtobuf
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/x-jsonlines":
+		return ContentTypeNDJSON
+	case "text/csv", "application/csv":
+		return ContentTypeCSV
+	case "application/octet-stream":
+		return ContentTypeOctetStream
+	case "application/merge-patch+json":
+		return ContentTypeMergePatch
+	case "application/json-patch+json":
+		return ContentTypeJSONPatch
 	default:
 		return ContentTypeUnknown
 	}
@@ -0,0 +1,254 @@
+package bind
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// csvField - CSV 열에 대응하는 구조체 필드
+// csvField - A struct field corresponding to a CSV column.
+type csvField struct {
+	name  string
+	index int
+}
+
+// csvFieldCache - 구조체 타입별 CSV 필드 목록 캐시
+// csvFieldCache - A cache of CSV fields per struct type.
+var csvFieldCache = &sync.Map{}
+
+// getCSVFields - 구조체 타입의 CSV 필드 목록을 반환합니다.
+// 열 이름은 csv 태그, 없으면 json 태그, 둘 다 없으면 필드 이름이며, `csv:"-"`인 필드는 제외합니다.
+// getCSVFields - Returns the CSV fields of a struct type.
+// The column name is the csv tag, then the json tag, then the field name; fields tagged `csv:"-"` are skipped.
+func getCSVFields(rt reflect.Type) []csvField {
+	if cached, ok := csvFieldCache.Load(rt); ok {
+		return cached.([]csvField)
+	}
+	var fields []csvField
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("csv"); ok {
+			name, _, _ = strings.Cut(tag, ",")
+		} else if tag, ok := sf.Tag.Lookup("json"); ok {
+			name, _, _ = strings.Cut(tag, ",")
+		}
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, csvField{name: name, index: i})
+	}
+	csvFieldCache.Store(rt, fields)
+	return fields
+}
+
+// csvDecoder - 헤더 행으로 열과 필드를 연결한 뒤 레코드를 하나씩 구조체에 디코딩합니다.
+// csvDecoder - Maps columns to fields using the header row, then decodes records into structs one at a time.
+type csvDecoder struct {
+	r       *csv.Reader
+	header  []string
+	columns []*csvField // 헤더 위치별 필드, 대응하는 필드가 없으면 nil
+}
+
+// newCSVDecoder - 헤더 행을 읽고 rt의 필드와 연결합니다.
+// 헤더는 정확히 일치하는 필드를, 없으면 대소문자를 구분하지 않고 일치하는 필드를 찾습니다.
+// strict이면 대응하는 필드가 없는 열에 ErrUnknownField를 반환합니다.
+// newCSVDecoder - Reads the header row and maps it to the fields of rt.
+// A header matches a field exactly, or else case-insensitively.
+// If strict, a column without a matching field returns ErrUnknownField.
+func newCSVDecoder(body io.Reader, rt reflect.Type, strict bool) (*csvDecoder, error) {
+	if rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("bind: CSV rows must be structs, got %s", rt)
+	}
+	r := csv.NewReader(body)
+	r.ReuseRecord = true
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, errors.New("bind: CSV body has no header row")
+	}
+	if err != nil {
+		return nil, csvReadError(err)
+	}
+	d := &csvDecoder{r: r, header: append([]string(nil), header...)}
+	if len(d.header) > 0 {
+		d.header[0] = strings.TrimPrefix(d.header[0], "\ufeff") // 스프레드시트가 붙이는 BOM
+	}
+	fields := getCSVFields(rt)
+	for i, name := range d.header {
+		name = strings.TrimSpace(name)
+		d.header[i] = name
+		var col *csvField
+		for j := range fields {
+			if fields[j].name == name {
+				col = &fields[j]
+				break
+			}
+		}
+		for j := range fields {
+			if col == nil && strings.EqualFold(fields[j].name, name) {
+				col = &fields[j]
+			}
+		}
+		if col == nil && strict {
+			return nil, BindError{Field: name, Line: 1, Err: fmt.Errorf("%w %q (column %d)", ErrUnknownField, name, i+1)}
+		}
+		d.columns = append(d.columns, col)
+	}
+	return d, nil
+}
+
+// read - 다음 레코드를 구조체 rv에 디코딩하고 레코드의 줄 번호를 반환합니다. 레코드가 더 없으면 io.EOF를 반환합니다.
+// 열 수가 맞지 않는 레코드는 건너뛸 수 있는 에러로 보고하며, 그 외의 읽기 에러 이후에는 읽기를 멈춰야 합니다.
+// read - Decodes the next record into the struct rv and returns the record's line number. Returns io.EOF when there are no more records.
+// A record with the wrong number of columns is reported as an error that can be skipped; reading must stop after any other read error.
+func (d *csvDecoder) read(rv reflect.Value) (int, error) {
+	record, err := d.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return parseErr.StartLine, err
+		}
+		return 0, csvReadError(err)
+	}
+	line, _ := d.r.FieldPos(0)
+	for i, s := range record {
+		if i >= len(d.columns) || d.columns[i] == nil {
+			continue
+		}
+		if err := setCSVValue(rv.Field(d.columns[i].index), s); err != nil {
+			return line, BindError{Field: d.header[i], Err: fmt.Errorf("column %d: %w", i+1, err)}
+		}
+	}
+	return line, nil
+}
+
+// csvRecoverable - 에러 이후에도 다음 레코드를 읽을 수 있는지 반환합니다.
+// csvRecoverable - Reports whether the next record can still be read after the error.
+func csvRecoverable(err error) bool {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return errors.Is(parseErr.Err, csv.ErrFieldCount)
+	}
+	var bindErr BindError
+	return errors.As(err, &bindErr)
+}
+
+// csvReadError - 본문 읽기 에러를 변환합니다. io.EOF는 그대로 둡니다.
+// csvReadError - Converts a body read error. io.EOF is left as is.
+func csvReadError(err error) error {
+	if err == io.EOF {
+		return err
+	}
+	return bodyReadError(err)
+}
+
+// setCSVValue - CSV 필드 문자열을 필드 타입으로 변환해 설정합니다.
+// 빈 문자열은 포인터를 nil로, 그 외 타입은 0 값으로 둡니다. encoding.TextUnmarshaler를 구현한 타입은
+// UnmarshalText를 사용하므로 time.Time은 RFC 3339 형식입니다.
+// setCSVValue - Converts a CSV field string to the field's type and sets it.
+// An empty string leaves pointers nil and other types at their zero value. Types implementing encoding.TextUnmarshaler
+// use UnmarshalText, so time.Time uses the RFC 3339 format.
+func setCSVValue(fv reflect.Value, s string) error {
+	if s == "" {
+		return nil
+	}
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+	if fv.Addr().Type().Implements(textUnmarshalerType) {
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported CSV field type %s", fv.Type())
+	}
+	return nil
+}
+
+// decodeCSVRequest - CSV 본문의 행을 구조체 슬라이스(또는 구조체 포인터 슬라이스)에 디코딩합니다.
+// 첫 행은 헤더이며, 각 행은 디코딩한 뒤 Binder 재귀를 실행합니다. 에러는 "[2].price"처럼 행 인덱스와 열 이름을 담은
+// BindError로 보고되며, Line에는 CSV 본문의 줄 번호가 담깁니다. 본문 크기 제한과 엄격한 디코딩이 적용됩니다.
+// decodeCSVRequest - Decodes the rows of a CSV body into a slice of structs (or of struct pointers).
+// The first row is the header, and each row runs the Binder recursion after decoding. Errors are reported as BindErrors carrying
+// the row index and column name, as in "[2].price", with the line number in the CSV body in Line. The body size limit and strict decoding apply.
+func decodeCSVRequest(r *http.Request, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("bind: CSV body requires a pointer to a slice, got %T", v)
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+	rowType := elemType
+	if rowType.Kind() == reflect.Ptr {
+		rowType = rowType.Elem()
+	}
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	d, err := newCSVDecoder(body, rowType, cfg.strictDecoding)
+	if err != nil {
+		return err
+	}
+	b := &binding{r: r, maxDepth: cfg.maxDepth}
+	for i := 0; ; i++ {
+		row := reflect.New(rowType)
+		line, err := d.read(row.Elem())
+		if err == io.EOF {
+			return nil
+		}
+		if err == nil {
+			err = b.bind(row, "", 0)
+		}
+		if err != nil {
+			return lineError(indexError(err, i), line)
+		}
+		if elemType.Kind() == reflect.Ptr {
+			slice.Set(reflect.Append(slice, row))
+		} else {
+			slice.Set(reflect.Append(slice, row.Elem()))
+		}
+	}
+}
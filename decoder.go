@@ -1,16 +1,20 @@
 package bind
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"io"
+	"maps"
 	"mime/multipart"
 	"net/http"
 	"reflect"
 	"sync"
 
 	"github.com/go-playground/form/v4"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -20,8 +24,22 @@ var (
 		ContentTypeXML:       decodeXMLRequest,
 		ContentTypeForm:      decodeFormRequest,
 		ContentTypeMultipart: decodeMultipartFormRequest,
+
+		ContentTypeMultipartMixed:   decodeMultipartPartsRequest,
+		ContentTypeMultipartRelated: decodeMultipartPartsRequest,
+		ContentTypeYAML:             decodeYAMLRequest,
+		ContentTypeTOML:             decodeTOMLRequest,
+		ContentTypeMsgPack:          decodeMsgPackRequest,
+		ContentTypeCBOR:             decodeCBORRequest,
+		ContentTypeProtobuf:         decodeProtobufRequest,
+		ContentTypeCSV:              decodeCSVRequest,
+		ContentTypePlainText:        decodeRawRequest,
+		ContentTypeHTML:             decodeRawRequest,
+		ContentTypeOctetStream:      decodeRawRequest,
+		ContentTypeEventStream:      decodeEventStreamRequest,
+		ContentTypeMergePatch:       decodeMergePatchRequest,
+		ContentTypeJSONPatch:        decodeJSONPatchRequest,
 	}
-	MaxMultipartMemory int64 = 32 << 20
 )
 
 // GetDecoder - 지정된 Content-Type에 대한 디코더 함수를 반환합니다.
@@ -35,10 +53,6 @@ func GetDecoder(ct ContentType) (func(*http.Request, any) error, bool) {
 	return dec, ok
 }
 
-func SetMaxMultipartMemory(size int64) {
-	MaxMultipartMemory = size
-}
-
 func DefaultDecoder(r *http.Request, v any) error {
 	ct := GetContentType(r.Header.Get("Content-Type"))
 	decoderMu.RLock()
@@ -57,36 +71,93 @@ func RegisterDecoder(ct ContentType, fn func(*http.Request, any) error) {
 }
 
 func decodeJSONRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
 	defer io.Copy(io.Discard, r.Body)
-	return json.NewDecoder(r.Body).Decode(v)
+	return decodeJSON(body, v, cfg)
+}
+
+// decodeJSON - JSON 문서 하나를 v에 디코딩합니다. 중첩 깊이 제한과 엄격한 디코딩을 적용하고, proto.Message는 protojson으로 디코딩하며, 대상의 Fields를 채웁니다.
+// JSON 본문과 JSON을 담은 다른 형식의 일부(멀티파트 루트 파트, SSE 이벤트 데이터)가 같은 규칙으로 디코딩되도록 공유합니다.
+// decodeJSON - Decodes a single JSON document into v. It applies the nesting depth limit and strict decoding, decodes proto.Message targets with protojson, and populates the target's Fields.
+// It is shared so that JSON bodies and JSON embedded in other formats (multipart root parts, SSE event data) are decoded by the same rules.
+func decodeJSON(in io.Reader, v any, cfg *config) error {
+	if m, ok := v.(proto.Message); ok {
+		return decodeProtoJSON(in, m, cfg)
+	}
+	in = newJSONDepthReader(in, cfg.maxNesting)
+	// 대상에 Fields가 있으면 문서를 한 번만 읽어 두고, 같은 바이트로 값을 디코딩하고 보낸 필드를 찾습니다.
+	fields := fieldsTarget(v)
+	var raw json.RawMessage
+	if fields.IsValid() {
+		if err := json.NewDecoder(in).Decode(&raw); err != nil {
+			return jsonError(err)
+		}
+		in = bytes.NewReader(raw)
+	}
+	dec := json.NewDecoder(in)
+	if cfg.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return jsonError(err)
+	}
+	if fields.IsValid() {
+		fields.Set(reflect.ValueOf(sentJSONFields(raw, reflect.TypeOf(v))))
+	}
+	return nil
 }
 
 func decodeXMLRequest(r *http.Request, v any) error {
+	body := limitBody(r, configFrom(r))
 	defer io.Copy(io.Discard, r.Body)
-	return xml.NewDecoder(r.Body).Decode(v)
+	return bodyReadError(xml.NewDecoder(body).Decode(v))
 }
 
 func decodeFormRequest(r *http.Request, v any) error {
+	limitBody(r, configFrom(r))
 	defer io.Copy(io.Discard, r.Body)
 	if err := r.ParseForm(); err != nil {
-		return err
+		return bodyReadError(err)
 	}
 	decoder := form.NewDecoder()
-	return decoder.Decode(v, r.PostForm)
+	if err := decoder.Decode(v, r.PostForm); err != nil {
+		return err
+	}
+	if fields := fieldsTarget(v); fields.IsValid() {
+		fields.Set(reflect.ValueOf(formFields(maps.Keys(r.PostForm))))
+	}
+	return nil
 }
 
 func decodeMultipartFormRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	if limit := cfg.uploadLimits.MaxTotalSize; limit > 0 {
+		r.Body = http.MaxBytesReader(nil, r.Body, limit)
+	}
 	defer io.Copy(io.Discard, r.Body)
-	if err := r.ParseMultipartForm(MaxMultipartMemory); err != nil {
-		return err
+	if cfg.streamMultipart {
+		return decodeMultipartStream(r, v, cfg)
 	}
-	if err := bindFiles(r, v); err != nil {
+	if err := readMultipartForm(r, v, cfg); err != nil {
 		return err
 	}
+	cfg.cleanup.add(r.MultipartForm.RemoveAll)
+	// form 디코더는 인덱스 키로 채운 슬라이스 요소를 통째로 교체하므로, 파일은 값을 디코딩한 뒤에 바인딩합니다.
 	decoder := form.NewDecoder()
-	return decoder.Decode(v, r.MultipartForm.Value)
+	if err := decoder.Decode(v, r.MultipartForm.Value); err != nil {
+		return err
+	}
+	if fields := fieldsTarget(v); fields.IsValid() {
+		fields.Set(reflect.ValueOf(formFields(maps.Keys(r.MultipartForm.Value), maps.Keys(r.MultipartForm.File))))
+	}
+	return bindFiles(r, v)
 }
 
+// bindFiles - 업로드된 파일을 키가 가리키는 필드에 바인딩합니다.
+// "documents[0].file"처럼 중첩 구조체, 임베드된 구조체, 구조체 슬라이스 안의 필드도 go-playground/form과 같은 이름 규칙으로 찾습니다.
+// bindFiles - Binds uploaded files to the fields their keys refer to.
+// Fields inside nested structs, embedded structs and slices of structs, such as "documents[0].file", are found using go-playground/form's naming rules.
 func bindFiles(r *http.Request, v any) error {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr {
@@ -96,29 +167,80 @@ func bindFiles(r *http.Request, v any) error {
 	if rv.Kind() != reflect.Struct {
 		return nil
 	}
-	rt := rv.Type()
-	fileHeaderPtrType := reflect.TypeOf((*multipart.FileHeader)(nil))
-	fileHeaderSliceType := reflect.TypeOf(([]*multipart.FileHeader)(nil))
-	for i := 0; i < rv.NumField(); i++ {
-		field := rv.Field(i)
-		fieldType := rt.Field(i)
-		formTag := fieldType.Tag.Get("form")
-		if formTag == "" {
-			continue
-		}
-		files, ok := r.MultipartForm.File[formTag]
+	if plan := getFilePlan(rv.Type()); plan.err != nil {
+		return plan.err
+	}
+	cfg := configFrom(r)
+	for _, key := range sortedKeys(r.MultipartForm.File) {
+		files := r.MultipartForm.File[key]
+		segs, ok := parseFileKey(key)
 		if !ok || len(files) == 0 {
 			continue
 		}
-		if !field.CanSet() {
+		ff, err := lookupFileField(rv.Type(), segs)
+		if err != nil {
+			return BindError{Field: key, Err: err}
+		}
+		if ff == nil {
 			continue
 		}
-		switch field.Type() {
-		case fileHeaderPtrType:
-			field.Set(reflect.ValueOf(files[0]))
-		case fileHeaderSliceType:
-			field.Set(reflect.ValueOf(files))
+		opts := ff.options(cfg)
+		if err := checkFileOptions(key, files, opts); err != nil {
+			return err
+		}
+		sums, err := verifyChecksums(r.MultipartForm, key, files, opts)
+		if err != nil {
+			return BindError{Field: key, Err: err}
+		}
+		field := fileFieldValue(rv, segs)
+		if err := assignFiles(r.Context(), cfg, field, files, opts); err != nil {
+			return BindError{Field: key, Err: err}
+		}
+		setChecksums(field, sums)
+	}
+	return nil
+}
+
+// assignFiles - 파싱된 업로드 파일을 필드의 타입에 맞게 설정합니다.
+// assignFiles - Sets parsed uploaded files on a field according to its type.
+func assignFiles(ctx context.Context, cfg *config, field reflect.Value, files []*multipart.FileHeader, opts fileOptions) error {
+	switch field.Type() {
+	case fileHeaderPtrType:
+		field.Set(reflect.ValueOf(files[0]))
+	case fileHeaderSliceType:
+		field.Set(reflect.ValueOf(files))
+	case filePtrType:
+		field.Set(reflect.ValueOf(newFile(files[0])))
+	case fileSliceType:
+		bound := make([]*File, len(files))
+		for i, fh := range files {
+			bound[i] = newFile(fh)
+		}
+		field.Set(reflect.ValueOf(bound))
+	case bytesType:
+		b, err := readFileBytes(files[0], bytesLimit(cfg, opts))
+		if err != nil {
+			return err
+		}
+		field.SetBytes(b)
+	case readCloserType, multipartFileType:
+		f, err := openTracked(ctx, cfg, files[0])
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(f))
+	case storedFilePtrType:
+		stored, err := storeFileHeaders(ctx, cfg, files[:1])
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(stored[0]))
+	case storedFileSliceType:
+		stored, err := storeFileHeaders(ctx, cfg, files)
+		if err != nil {
+			return err
 		}
+		field.Set(reflect.ValueOf(stored))
 	}
 	return nil
 }
@@ -0,0 +1,1506 @@
+package bind_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DevNewbie1826/bind"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type RelatedPayload struct {
+	Title    string       `json:"title"`
+	Data     *bind.Part   `json:"-" part:"data"`
+	Thumb    []byte       `json:"-" part:"#2"`
+	Note     string       `json:"-" part:"<note@example.com>"`
+	Others   []*bind.Part `json:"-" part:"*"`
+	Untagged *bind.Part   `json:"-"`
+}
+
+func (p *RelatedPayload) Bind(r *http.Request) error { return nil }
+
+type BadPartTagPayload struct {
+	Count int `part:"count"`
+}
+
+func (p *BadPartTagPayload) Bind(r *http.Request) error { return nil }
+
+type YAMLItem struct {
+	SKU   string `yaml:"sku"`
+	Count int    `json:"count"`
+}
+
+type YAMLBase struct {
+	Region string `yaml:"region"`
+}
+
+type YAMLPayload struct {
+	YAMLBase `yaml:",inline"`
+	Name     string            `yaml:"name" json:"ignored"`
+	Owner    string            `json:"owner_name"`
+	Enabled  bool              // 태그가 없으면 소문자 필드 이름("enabled")
+	Items    []YAMLItem        `yaml:"items"`
+	Labels   map[string]string `yaml:"labels"`
+	Parent   *YAMLItem         `json:"parent"`
+	Skipped  string            `yaml:"-"`
+}
+
+func (p *YAMLPayload) Bind(r *http.Request) error { return nil }
+
+type AliasBombPayload struct {
+	G [][][][][][][]string `yaml:"g"`
+}
+
+func (p *AliasBombPayload) Bind(r *http.Request) error { return nil }
+
+type TOMLServer struct {
+	Host  string `toml:"host"`
+	Port  int    `toml:"port"`
+	Ports []int  `toml:"ports"`
+}
+
+type TOMLPayload struct {
+	Title   string       `toml:"title"`
+	Servers []TOMLServer `toml:"servers"`
+	Owner   struct {
+		Name string `toml:"name"`
+	} `toml:"owner"`
+	Check *Checker `toml:"check"`
+}
+
+func (p *TOMLPayload) Bind(r *http.Request) error { return nil }
+
+// Checker - 중첩된 Binder가 호출되는지 확인하기 위한 타입
+type Checker struct {
+	Path  string `toml:"path" json:"path"`
+	Bound bool   `toml:"-" json:"-"`
+}
+
+func (c *Checker) Bind(r *http.Request) error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+	c.Bound = true
+	return nil
+}
+
+type MsgPackPayload struct {
+	Name    string            `msgpack:"name"`
+	Count   int               `json:"count"`
+	Tags    []string          `msgpack:"tags"`
+	Labels  map[string]string `msgpack:"labels"`
+	Data    []byte            `msgpack:"data"`
+	Ignored string            `msgpack:"-"`
+	Check   *Checker          `msgpack:"check"`
+}
+
+func (p *MsgPackPayload) Bind(r *http.Request) error { return nil }
+
+type CBORPayload struct {
+	DeviceID string    `cbor:"device_id"`
+	Reading  float64   `json:"reading"`
+	Taken    time.Time `cbor:"taken"`
+	Seen     time.Time `cbor:"seen"`
+	Raw      []byte    `cbor:"raw"`
+	Token    string    `cbor:"token"`
+	Check    *Checker  `cbor:"check"`
+}
+
+func (p *CBORPayload) Bind(r *http.Request) error { return nil }
+
+// ProtoPayload - 생성된 protobuf 메시지를 임베드해 proto.Message와 Binder를 함께 구현하는 타입
+type ProtoPayload struct {
+	descriptorpb.FileDescriptorProto
+	Bound bool
+}
+
+func (p *ProtoPayload) Bind(r *http.Request) error {
+	p.Bound = true
+	return nil
+}
+
+type StreamRecord struct {
+	ID    int      `json:"id"`
+	Name  string   `json:"name"`
+	Check *Checker `json:"check"`
+}
+
+func (s *StreamRecord) Bind(r *http.Request) error {
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+type PriceEdit struct {
+	SKU       string     `csv:"sku"`
+	Price     float64    `csv:"price"`
+	Stock     *int       `json:"stock"`
+	Active    bool       // 태그가 없으면 필드 이름("Active", 대소문자 무시)
+	UpdatedAt *time.Time `csv:"updated_at"`
+	Note      string     `csv:"-"`
+}
+
+func (p *PriceEdit) Bind(r *http.Request) error {
+	if p.Price < 0 {
+		return errors.New("price must not be negative")
+	}
+	return nil
+}
+
+type PriceEdits []PriceEdit
+
+func (p *PriceEdits) Bind(r *http.Request) error { return nil }
+
+type TextNote string
+
+func (n *TextNote) Bind(r *http.Request) error {
+	if *n == "" {
+		return errors.New("note is empty")
+	}
+	return nil
+}
+
+type Blob []byte
+
+func (b *Blob) Bind(r *http.Request) error { return nil }
+
+type RawUpload struct {
+	Body io.Reader `bind:"body"`
+}
+
+func (u *RawUpload) Bind(r *http.Request) error { return nil }
+
+type RawBytesUpload struct {
+	Name string
+	Data []byte `bind:"body"`
+}
+
+func (u *RawBytesUpload) Bind(r *http.Request) error { return nil }
+
+type SSEPayload struct {
+	User  string `json:"user"`
+	Count int    `json:"count"`
+}
+
+func (p *SSEPayload) Bind(r *http.Request) error {
+	if p.User == "" {
+		return errors.New("user is required")
+	}
+	return nil
+}
+
+type SSERelay []bind.Event[SSEPayload]
+
+func (s *SSERelay) Bind(r *http.Request) error { return nil }
+
+type PatchAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type KeyedPatch struct {
+	X any `json:"x"`
+}
+
+func (p *KeyedPatch) Bind(r *http.Request) error { return nil }
+
+type ProfilePatch struct {
+	Name    string            `json:"name"`
+	Email   string            `json:"email"`
+	Age     int               `json:"age"`
+	Address *PatchAddress     `json:"address"`
+	Tags    map[string]string `json:"tags"`
+	Meta    any               `json:"meta"`
+	Version int               `json:"-"`
+	Bound   bool              `json:"-"`
+}
+
+func (p *ProfilePatch) Bind(r *http.Request) error {
+	if p.Name == "" {
+		return errors.New("name is required")
+	}
+	p.Bound = true
+	return nil
+}
+
+type AccountUpdate struct {
+	bind.Fields
+	Email   string        `json:"email" form:"email"`
+	Age     int           `json:"age" form:"age"`
+	Address *PatchAddress `json:"address" form:"address"`
+	Avatar  []byte        `json:"-" form:"avatar"`
+	Checked []string      `json:"-" form:"-"`
+}
+
+func (u *AccountUpdate) Bind(r *http.Request) error {
+	// 보낸 필드만 검증합니다.
+	if u.Has("email") && u.Email == "" {
+		return errors.New("email cannot be cleared")
+	}
+	u.Checked = u.Paths()
+	return nil
+}
+
+// newBodyRequest - 주어진 Content-Type과 본문으로 요청을 생성합니다.
+func newBodyRequest(contentType, body string) *http.Request {
+	req, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+// expectBindErrorField - err가 target을 감싼 BindError이고 Field가 field인지 확인합니다.
+func expectBindErrorField(t *testing.T, err, target error, field string) {
+	t.Helper()
+	if target != nil && !errors.Is(err, target) {
+		t.Fatalf("expected %v, got %v", target, err)
+	}
+	var bindErr bind.BindError
+	if !errors.As(err, &bindErr) || bindErr.Field != field {
+		t.Errorf("expected error for field %q, got %v", field, err)
+	}
+}
+
+// relatedPart - 테스트 요청에 포함될 multipart/mixed, multipart/related 파트
+type relatedPart struct {
+	id          string
+	contentType string
+	body        string
+}
+
+// newRelatedRequest - 주어진 파트들로 contentType 본문의 요청을 생성합니다. params는 Content-Type 뒤에 붙습니다.
+func newRelatedRequest(t *testing.T, contentType, params string, parts ...relatedPart) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, p := range parts {
+		h := make(textproto.MIMEHeader)
+		if p.id != "" {
+			h.Set("Content-ID", "<"+p.id+">")
+		}
+		if p.contentType != "" {
+			h.Set("Content-Type", p.contentType)
+		}
+		w, err := writer.CreatePart(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(p.body))
+	}
+	writer.Close()
+	req, _ := http.NewRequest("POST", "/", body)
+	req.Header.Set("Content-Type", contentType+"; boundary="+writer.Boundary()+params)
+	return req
+}
+
+func TestAction_MultipartRelated(t *testing.T) {
+	req := newRelatedRequest(t, "multipart/related", `; type="application/json"`,
+		relatedPart{id: "meta", body: `{"title":"report"}`},
+		relatedPart{id: "data", contentType: "application/pdf", body: "%PDF"},
+		relatedPart{contentType: "image/png", body: "png"},
+		relatedPart{id: "note@example.com", contentType: "text/plain", body: "hello"},
+		relatedPart{id: "extra", body: "x"},
+	)
+	payload := &RelatedPayload{}
+	if err := bind.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Title != "report" {
+		t.Errorf("expected title %q, got %q", "report", payload.Title)
+	}
+	if payload.Data == nil || string(payload.Data.Body) != "%PDF" || payload.Data.ContentType() != "application/pdf" {
+		t.Errorf("unexpected data part: %+v", payload.Data)
+	}
+	if string(payload.Thumb) != "png" || payload.Note != "hello" {
+		t.Errorf("unexpected thumb %q or note %q", payload.Thumb, payload.Note)
+	}
+	if len(payload.Others) != 1 || payload.Others[0].ContentID != "extra" {
+		t.Errorf("expected the extra part in Others, got %+v", payload.Others)
+	}
+}
+
+func TestAction_MultipartRelatedStart(t *testing.T) {
+	req := newRelatedRequest(t, "multipart/related", `; start="<meta>"`,
+		relatedPart{id: "data", body: "binary"},
+		relatedPart{id: "meta", contentType: "application/json", body: `{"title":"second"}`},
+	)
+	payload := &RelatedPayload{}
+	if err := bind.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Title != "second" || payload.Data == nil || string(payload.Data.Body) != "binary" {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+
+	req = newRelatedRequest(t, "multipart/related", `; start="<missing>"`, relatedPart{id: "data", body: "binary"})
+	if err := bind.Action(req, &RelatedPayload{}); err == nil {
+		t.Error("expected an error for a missing start part")
+	}
+}
+
+func TestAction_MultipartRelatedRootJSON(t *testing.T) {
+	// 루트 JSON 파트도 JSON 본문과 같은 규칙으로 디코딩됩니다.
+	newReq := func(root string) *http.Request {
+		return newRelatedRequest(t, "multipart/related", `; type="application/json"`, relatedPart{id: "meta", body: root})
+	}
+	err := bind.Action(newReq(`{"title":"a","extra":1}`), &RelatedPayload{}, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "extra")
+
+	expectBindErrorField(t, bind.Action(newReq(`{"title":5}`), &RelatedPayload{}), nil, "title")
+
+	err = bind.Action(newReq(`{"title":"a","x":[[[[1]]]]}`), &RelatedPayload{}, bind.WithMaxNestingDepth(3))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+}
+
+func TestAction_MultipartMixed(t *testing.T) {
+	req := newRelatedRequest(t, "multipart/mixed", "",
+		relatedPart{contentType: "application/http", body: "GET /a HTTP/1.1"},
+		relatedPart{contentType: "application/http", body: "GET /b HTTP/1.1"},
+		relatedPart{contentType: "application/http", body: "GET /c HTTP/1.1"},
+	)
+	payload := &RelatedPayload{}
+	if err := bind.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// JSON이 아닌 첫 번째 파트도 위치로 바인딩됩니다.
+	if len(payload.Others) != 2 || string(payload.Others[0].Body) != "GET /a HTTP/1.1" {
+		t.Errorf("expected parts 0 and 1 in Others, got %+v", payload.Others)
+	}
+	if string(payload.Thumb) != "GET /c HTTP/1.1" {
+		t.Errorf("expected part #2 in Thumb, got %q", payload.Thumb)
+	}
+}
+
+func TestAction_MultipartRelatedLimits(t *testing.T) {
+	req := newRelatedRequest(t, "multipart/related", "",
+		relatedPart{contentType: "application/json", body: `{"title":"big"}`},
+		relatedPart{id: "data", body: string(bytes.Repeat([]byte("a"), 64))},
+	)
+	err := bind.Action(req, &RelatedPayload{}, bind.WithMaxMultipartMemory(32))
+	if !errors.Is(err, bind.ErrUploadTooLarge) {
+		t.Errorf("expected ErrUploadTooLarge, got %v", err)
+	}
+
+	req = newRelatedRequest(t, "multipart/related", "", relatedPart{id: "count", body: "1"})
+	if err := bind.Action(req, &BadPartTagPayload{}); err == nil {
+		t.Error("expected an error for an unsupported part field type")
+	}
+}
+
+const yamlDoc = `
+defaults: &defaults
+  count: 1
+region: eu
+name: app
+owner_name: kim
+enabled: true
+skipped: nope
+items:
+  - sku: A1
+    <<: *defaults
+  - sku: B2
+    count: 3
+labels:
+  tier: gold
+parent:
+  sku: P
+`
+
+func TestAction_YAMLBinding(t *testing.T) {
+	for _, ct := range []string{"application/yaml", "application/x-yaml", "text/yaml; charset=utf-8"} {
+		payload := &YAMLPayload{}
+		if err := bind.Action(newBodyRequest(ct, yamlDoc), payload); err != nil {
+			t.Fatalf("%s: unexpected error: %v", ct, err)
+		}
+		if payload.Region != "eu" || payload.Name != "app" || payload.Owner != "kim" || !payload.Enabled || payload.Skipped != "" {
+			t.Errorf("%s: unexpected payload: %+v", ct, payload)
+		}
+		if len(payload.Items) != 2 || payload.Items[0].Count != 1 || payload.Items[1].Count != 3 {
+			t.Errorf("%s: unexpected items: %+v", ct, payload.Items)
+		}
+		if payload.Labels["tier"] != "gold" || payload.Parent == nil || payload.Parent.SKU != "P" {
+			t.Errorf("%s: unexpected labels or parent: %+v", ct, payload)
+		}
+	}
+}
+
+func TestAction_YAMLErrorPath(t *testing.T) {
+	err := bind.Action(newBodyRequest("application/yaml", "items:\n  - sku: A\n    count: many\n"), &YAMLPayload{})
+	expectBindErrorField(t, err, nil, "items[0].count")
+
+	deep := strings.Repeat("[", 20) + strings.Repeat("]", 20)
+	err = bind.Action(newBodyRequest("application/yaml", "items: "+deep), &YAMLPayload{}, bind.WithMaxNestingDepth(10))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+
+	err = bind.Action(newBodyRequest("application/yaml", yamlDoc), &YAMLPayload{}, bind.WithMaxBodySize(16))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge, got %v", err)
+	}
+}
+
+func TestAction_YAMLExactKeys(t *testing.T) {
+	// yaml.v3와 같이 대소문자가 다른 키는 필드에 연결되지 않습니다.
+	payload := &YAMLPayload{}
+	if err := bind.Action(newBodyRequest("application/yaml", "Region: eu\nNAME: app\nEnabled: true\n"), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Region != "" || payload.Name != "" || payload.Enabled {
+		t.Errorf("expected keys with different case to be ignored, got %+v", payload)
+	}
+	err := bind.Action(newBodyRequest("application/yaml", "Region: eu\n"), &YAMLPayload{}, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "Region")
+}
+
+func TestAction_YAMLAliasExpansion(t *testing.T) {
+	// 각 단계가 앞 단계를 10번 참조하므로 완전히 확장하면 10^7개의 노드가 됩니다.
+	doc := "a: &a [x, x, x, x, x, x, x, x, x, x]\n"
+	prev := "a"
+	for _, name := range []string{"b", "c", "d", "e", "f", "g"} {
+		doc += name + ": &" + name + " [" + strings.TrimSuffix(strings.Repeat("*"+prev+", ", 10), ", ") + "]\n"
+		prev = name
+	}
+	err := bind.Action(newBodyRequest("application/yaml", doc), &AliasBombPayload{})
+	var bindErr bind.BindError
+	if !errors.As(err, &bindErr) || !strings.HasPrefix(bindErr.Field, "g[") {
+		t.Errorf("expected excessive aliasing error under g, got %v", err)
+	}
+}
+
+func TestAction_JSONErrorPath(t *testing.T) {
+	err := bind.Action(newBodyRequest("application/json", `{"outer_field":"x","inner":{"value":"nan"}}`), &NestedPayload{})
+	expectBindErrorField(t, err, nil, "inner.value")
+
+	err = bind.Action(newBodyRequest("application/json", `{"name":"`+strings.Repeat("a", 64)+`"}`), &TestPayload{}, bind.WithMaxBodySize(32))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge, got %v", err)
+	}
+
+	deep := `{"name":"[{","value":` + strings.Repeat("[", 20) + strings.Repeat("]", 20) + `}`
+	err = bind.Action(newBodyRequest("application/json", deep), &TestPayload{}, bind.WithMaxNestingDepth(10))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+
+	// 기본 제한에서는 encoding/json의 깊이 초과 에러가 ErrMaxDepthExceeded로 변환됩니다.
+	n := bind.DefaultMaxNestingDepth + 1
+	deep = `{"value":` + strings.Repeat("[", n) + strings.Repeat("]", n) + `}`
+	err = bind.Action(newBodyRequest("application/json", deep), &TestPayload{})
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded at the default limit, got %v", err)
+	}
+}
+
+func TestRegisterContentType(t *testing.T) {
+	const ct bind.ContentType = 100
+	bind.RegisterContentType("application/vnd.example+yaml", ct)
+	if got := bind.GetContentType("application/vnd.example+yaml; charset=utf-8"); got != ct {
+		t.Errorf("expected %v, got %v", ct, got)
+	}
+}
+
+const tomlDoc = `
+title = "cluster"
+check = { path = "/healthz" }
+
+[owner]
+name = "ops"
+
+[[servers]]
+host = "a"
+port = 80
+
+[[servers]]
+host = "b"
+ports = [8080, 8081]
+`
+
+func TestAction_TOMLBinding(t *testing.T) {
+	payload := &TOMLPayload{}
+	if err := bind.Action(newBodyRequest("application/toml", tomlDoc), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Title != "cluster" || payload.Owner.Name != "ops" || len(payload.Servers) != 2 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if s := payload.Servers[1]; s.Host != "b" || len(s.Ports) != 2 {
+		t.Errorf("unexpected second server: %+v", s)
+	}
+	if payload.Check == nil || !payload.Check.Bound {
+		t.Errorf("expected nested Binder to run, got %+v", payload.Check)
+	}
+}
+
+func TestAction_TOMLErrorPath(t *testing.T) {
+	tests := []struct {
+		name, doc, field string
+	}{
+		{"array table", "[[servers]]\nport = 1\n[[servers]]\nport = \"x\"\n", "servers[1].port"},
+		{"array value", "[[servers]]\nports = [1, \"two\"]\n", "servers[0].ports[1]"},
+		{"inline table", "check = { path = 1 }\n", "check.path"},
+		{"dotted key", "owner.name = 5\n", "owner.name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := bind.Action(newBodyRequest("application/toml", tt.doc), &TOMLPayload{})
+			expectBindErrorField(t, err, nil, tt.field)
+		})
+	}
+
+	err := bind.Action(newBodyRequest("application/toml", tomlDoc), &TOMLPayload{}, bind.WithMaxBodySize(16))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge, got %v", err)
+	}
+	deep := "title = \"[[[[\" # [[[[\nx = " + strings.Repeat("[", 20) + strings.Repeat("]", 20) + "\n"
+	err = bind.Action(newBodyRequest("application/toml", deep), &TOMLPayload{}, bind.WithMaxNestingDepth(10))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+	shallow := "title = '''[[[[[[[[[[[['''\n# [[[[[[[[[[[[\n"
+	if err := bind.Action(newBodyRequest("application/toml", shallow), &TOMLPayload{}, bind.WithMaxNestingDepth(10)); err != nil {
+		t.Errorf("brackets in strings and comments should not count, got %v", err)
+	}
+}
+
+// marshalMsgPack - 디코더와 같이 json 태그를 대체 태그로 사용해 값을 MessagePack으로 인코딩합니다.
+func marshalMsgPack(t *testing.T, v any) string {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	if err := enc.Encode(v); err != nil {
+		t.Fatalf("failed to encode msgpack: %v", err)
+	}
+	return buf.String()
+}
+
+func TestAction_MsgPackBinding(t *testing.T) {
+	want := &MsgPackPayload{
+		Name:    "gateway",
+		Count:   3,
+		Tags:    []string{"a", "b"},
+		Labels:  map[string]string{"env": "prod"},
+		Data:    []byte{0, 1, 2},
+		Ignored: "dropped",
+		Check:   &Checker{Path: "/healthz"},
+	}
+	for _, ct := range []string{"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"} {
+		t.Run(ct, func(t *testing.T) {
+			payload := &MsgPackPayload{}
+			if err := bind.Action(newBodyRequest(ct, marshalMsgPack(t, want)), payload); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.Name != want.Name || payload.Count != want.Count || len(payload.Tags) != 2 ||
+				payload.Labels["env"] != "prod" || !bytes.Equal(payload.Data, want.Data) {
+				t.Errorf("unexpected payload: %+v", payload)
+			}
+			if payload.Ignored != "" {
+				t.Errorf("expected ignored field to stay empty, got %q", payload.Ignored)
+			}
+			if payload.Check == nil || !payload.Check.Bound {
+				t.Errorf("expected nested Binder to run, got %+v", payload.Check)
+			}
+		})
+	}
+}
+
+func TestAction_MsgPackLimits(t *testing.T) {
+	body := marshalMsgPack(t, map[string]any{"name": strings.Repeat("a", 64)})
+	err := bind.Action(newBodyRequest("application/msgpack", body), &MsgPackPayload{}, bind.WithMaxBodySize(32))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge, got %v", err)
+	}
+
+	var deep any = "leaf"
+	for i := 0; i < 20; i++ {
+		deep = []any{deep}
+	}
+	body = marshalMsgPack(t, map[string]any{"name": "x", "tags": deep})
+	err = bind.Action(newBodyRequest("application/msgpack", body), &MsgPackPayload{}, bind.WithMaxNestingDepth(10))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+
+	body = marshalMsgPack(t, map[string]any{"name": "x", "extra": 1})
+	if err := bind.Action(newBodyRequest("application/msgpack", body), &MsgPackPayload{}); err != nil {
+		t.Errorf("expected unknown field to be ignored, got %v", err)
+	}
+	err = bind.Action(newBodyRequest("application/msgpack", body), &MsgPackPayload{}, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "extra")
+}
+
+func TestAction_StrictDecoding(t *testing.T) {
+	tests := []struct {
+		name, contentType, body, field string
+	}{
+		{"json", "application/json", `{"name":"x","extra":1}`, "extra"},
+		{"yaml", "application/yaml", "name: x\nitems:\n  - sku: a\n    extra: 1\n", "items[0].extra"},
+		{"toml", "application/toml", "title = \"x\"\n[owner]\nname = \"ops\"\nextra = 1\n", "owner.extra"},
+	}
+	targets := map[string]func() bind.Binder{
+		"json": func() bind.Binder { return &TestPayload{} },
+		"yaml": func() bind.Binder { return &YAMLPayload{} },
+		"toml": func() bind.Binder { return &TOMLPayload{} },
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := bind.Action(newBodyRequest(tt.contentType, tt.body), targets[tt.name]()); err != nil {
+				t.Fatalf("expected unknown field to be ignored, got %v", err)
+			}
+			err := bind.Action(newBodyRequest(tt.contentType, tt.body), targets[tt.name](), bind.WithStrictDecoding(true))
+			expectBindErrorField(t, err, bind.ErrUnknownField, tt.field)
+		})
+	}
+}
+
+// marshalCBOR - 값을 CBOR로 인코딩합니다.
+func marshalCBOR(t *testing.T, v any) string {
+	t.Helper()
+	data, err := cbor.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode cbor: %v", err)
+	}
+	return string(data)
+}
+
+func TestAction_CBORBinding(t *testing.T) {
+	taken := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	body := marshalCBOR(t, map[string]any{
+		"device_id": "sensor-7",
+		"reading":   21.5,
+		"taken":     cbor.Tag{Number: 1, Content: taken.Unix()},
+		"seen":      cbor.Tag{Number: 0, Content: taken.Format(time.RFC3339)},
+		"raw":       []byte{0xde, 0xad},
+		"token":     cbor.Tag{Number: 23, Content: []byte{0xbe, 0xef}},
+		"check":     map[string]any{"path": "/healthz"},
+	})
+	payload := &CBORPayload{}
+	if err := bind.Action(newBodyRequest("application/cbor", body), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.DeviceID != "sensor-7" || payload.Reading != 21.5 || !bytes.Equal(payload.Raw, []byte{0xde, 0xad}) {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+	if !payload.Taken.Equal(taken) || !payload.Seen.Equal(taken) {
+		t.Errorf("expected timestamps %v, got %v and %v", taken, payload.Taken, payload.Seen)
+	}
+	if payload.Token != "beef" {
+		t.Errorf("expected base16 encoded byte string, got %q", payload.Token)
+	}
+	if payload.Check == nil || !payload.Check.Bound {
+		t.Errorf("expected nested Binder to run, got %+v", payload.Check)
+	}
+}
+
+func TestAction_CBORLimits(t *testing.T) {
+	body := marshalCBOR(t, map[string]any{"device_id": strings.Repeat("a", 64)})
+	err := bind.Action(newBodyRequest("application/cbor", body), &CBORPayload{}, bind.WithMaxBodySize(32))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge, got %v", err)
+	}
+
+	var deep any = "leaf"
+	for i := 0; i < 20; i++ {
+		deep = []any{deep}
+	}
+	body = marshalCBOR(t, map[string]any{"device_id": "x", "raw": deep})
+	err = bind.Action(newBodyRequest("application/cbor", body), &CBORPayload{}, bind.WithMaxNestingDepth(10))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+
+	body = marshalCBOR(t, map[string]any{"device_id": "x", "extra": 1})
+	if err := bind.Action(newBodyRequest("application/cbor", body), &CBORPayload{}); err != nil {
+		t.Errorf("expected unknown field to be ignored, got %v", err)
+	}
+	err = bind.Action(newBodyRequest("application/cbor", body), &CBORPayload{}, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "extra")
+
+	body = marshalCBOR(t, map[string]any{"device_id": "x", "check": map[string]any{"path": "p", "mode": 1}})
+	err = bind.Action(newBodyRequest("application/cbor", body), &CBORPayload{}, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "check.mode")
+}
+
+// nestedDescriptor - depth 단계로 중첩된 메시지 정의를 가진 파일 정의를 만듭니다.
+func nestedDescriptor(depth int) *descriptorpb.FileDescriptorProto {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String("Leaf")}
+	for i := 1; i < depth; i++ {
+		msg = &descriptorpb.DescriptorProto{Name: proto.String("Outer"), NestedType: []*descriptorpb.DescriptorProto{msg}}
+	}
+	return &descriptorpb.FileDescriptorProto{Name: proto.String("nested.proto"), MessageType: []*descriptorpb.DescriptorProto{msg}}
+}
+
+func TestAction_ProtobufBinding(t *testing.T) {
+	data, err := proto.Marshal(nestedDescriptor(3))
+	if err != nil {
+		t.Fatalf("failed to encode protobuf: %v", err)
+	}
+	for _, ct := range []string{"application/x-protobuf", "application/protobuf"} {
+		t.Run(ct, func(t *testing.T) {
+			payload := &ProtoPayload{}
+			if err := bind.Action(newBodyRequest(ct, string(data)), payload); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.GetName() != "nested.proto" || payload.GetMessageType()[0].GetNestedType()[0].GetName() != "Outer" {
+				t.Errorf("unexpected payload: %v", &payload.FileDescriptorProto)
+			}
+			if !payload.Bound {
+				t.Error("expected Bind to run after decoding")
+			}
+		})
+	}
+
+	err = bind.Action(newBodyRequest("application/x-protobuf", string(data)), &TestPayload{})
+	if !errors.Is(err, bind.ErrNotProtoMessage) {
+		t.Errorf("expected ErrNotProtoMessage, got %v", err)
+	}
+}
+
+func TestAction_ProtoJSONBinding(t *testing.T) {
+	body := `{"name":"api.proto","package":"api.v1","messageType":[{"name":"Request"}]}`
+	payload := &ProtoPayload{}
+	if err := bind.Action(newBodyRequest("application/json", body), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.GetPackage() != "api.v1" || payload.GetMessageType()[0].GetName() != "Request" || !payload.Bound {
+		t.Errorf("unexpected payload: %v (bound %v)", &payload.FileDescriptorProto, payload.Bound)
+	}
+
+	body = `{"name":"api.proto","extra":1}`
+	if err := bind.Action(newBodyRequest("application/json", body), &ProtoPayload{}); err != nil {
+		t.Errorf("expected unknown field to be ignored, got %v", err)
+	}
+	err := bind.Action(newBodyRequest("application/json", body), &ProtoPayload{}, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "extra")
+}
+
+func TestAction_ProtobufNestingLimit(t *testing.T) {
+	data, err := proto.Marshal(nestedDescriptor(20))
+	if err != nil {
+		t.Fatalf("failed to encode protobuf: %v", err)
+	}
+	err = bind.Action(newBodyRequest("application/x-protobuf", string(data)), &ProtoPayload{}, bind.WithMaxNestingDepth(10))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+	if err := bind.Action(newBodyRequest("application/x-protobuf", string(data)), &ProtoPayload{}); err != nil {
+		t.Errorf("unexpected error with default limit: %v", err)
+	}
+}
+
+func TestStream_NDJSON(t *testing.T) {
+	body := `{"id":1,"name":"a","check":{"path":"/a"}}
+{"id":2,"name":""}
+
+{"id":"three","name":"c"}
+{"id":4,"name":"d","check":{}}
+{"id":5,"name":"e"}`
+	var ids []int
+	var errs []bind.BindError
+	for rec, err := range bind.Stream[StreamRecord](newBodyRequest("application/x-ndjson", body)) {
+		if err != nil {
+			var bindErr bind.BindError
+			if !errors.As(err, &bindErr) {
+				t.Fatalf("expected BindError, got %v", err)
+			}
+			errs = append(errs, bindErr)
+			continue
+		}
+		ids = append(ids, rec.ID)
+		if rec.Check != nil && !rec.Check.Bound {
+			t.Errorf("expected nested Binder to run for record %d", rec.ID)
+		}
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 5 {
+		t.Errorf("expected records 1 and 5, got %v", ids)
+	}
+	want := []struct {
+		line  int
+		field string
+	}{{2, ""}, {4, "id"}, {5, "Check"}}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), errs)
+	}
+	for i, w := range want {
+		if errs[i].Line != w.line || errs[i].Field != w.field {
+			t.Errorf("error %d: expected line %d field %q, got line %d field %q (%v)", i, w.line, w.field, errs[i].Line, errs[i].Field, errs[i])
+		}
+	}
+}
+
+func TestStream_NDJSONPointerAndBreak(t *testing.T) {
+	body := "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n{\"id\":3}\n"
+	var got []*StreamRecord
+	for rec, err := range bind.Stream[*StreamRecord](newBodyRequest("application/x-ndjson", body)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, rec)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if len(got) != 2 || got[1].Name != "b" {
+		t.Errorf("unexpected records: %+v", got)
+	}
+}
+
+func TestStream_NDJSONLimits(t *testing.T) {
+	body := strings.Repeat("{\"id\":1,\"name\":\"a\"}\n", 10)
+	var errs []error
+	count := 0
+	for _, err := range bind.Stream[StreamRecord](newBodyRequest("application/x-ndjson", body), bind.WithMaxBodySize(64)) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], bind.ErrBodyTooLarge) || count != 3 {
+		t.Errorf("expected three records and ErrBodyTooLarge, got %d records and %v", count, errs)
+	}
+
+	// 제한을 넘는 줄은 그 줄의 에러만 전달하고 다음 줄부터 계속 읽습니다.
+	long := `{"id":2,"name":"` + strings.Repeat("a", 8<<10) + `"}`
+	body = "{\"id\":1,\"name\":\"a\"}\n" + long + "\n{\"id\":3,\"name\":\"c\"}\n"
+	ids, errs := collectStream(t, bind.Stream[StreamRecord](newBodyRequest("application/x-ndjson", body), bind.WithMaxStreamLineSize(1024)))
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 || len(errs) != 1 {
+		t.Fatalf("expected records 1 and 3 and one error, got %v and %v", ids, errs)
+	}
+	var bindErr bind.BindError
+	if !errors.Is(errs[0], bind.ErrLineTooLong) || !errors.As(errs[0], &bindErr) || bindErr.Line != 2 {
+		t.Errorf("expected ErrLineTooLong on line 2, got %v", errs[0])
+	}
+
+	// StreamWith는 엔진의 옵션을 적용하고, 호출별 옵션이 그 위에 덮어씁니다.
+	engine := bind.New(bind.WithMaxStreamRecords(2))
+	ids, errs = collectStream(t, bind.StreamWith[StreamRecord](engine, newBodyRequest("application/x-ndjson", body)))
+	if len(ids) != 2 || len(errs) != 1 || !errors.Is(errs[0], bind.ErrTooManyRecords) {
+		t.Errorf("expected two records and ErrTooManyRecords, got %v and %v", ids, errs)
+	}
+	ids, errs = collectStream(t, bind.StreamWith[StreamRecord](engine, newBodyRequest("application/x-ndjson", body), bind.WithMaxStreamRecords(0)))
+	if len(ids) != 3 || len(errs) != 0 {
+		t.Errorf("expected per-call options to override the engine, got %v and %v", ids, errs)
+	}
+
+	for _, err := range bind.Stream[StreamRecord](newBodyRequest("application/xml", "<a/>")) {
+		if !errors.Is(err, bind.ErrUnsupportedStream) {
+			t.Errorf("expected ErrUnsupportedStream, got %v", err)
+		}
+	}
+}
+
+// collectStream - Stream의 결과를 레코드 ID와 에러 목록으로 모읍니다.
+func collectStream(t *testing.T, seq func(func(StreamRecord, error) bool)) (ids []int, errs []error) {
+	t.Helper()
+	for rec, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, rec.ID)
+	}
+	return ids, errs
+}
+
+func TestStream_JSONArray(t *testing.T) {
+	body := `[
+		{"id":1,"name":"a"},
+		{"id":"two","name":"b"},
+		{"id":3,"name":""},
+		{"id":4,"name":"d","check":{}},
+		{"id":5,"name":"e","extra":true},
+		{"id":6,"name":"f"}
+	]`
+	ids, errs := collectStream(t, bind.Stream[StreamRecord](newBodyRequest("application/json", body), bind.WithStrictDecoding(true)))
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 6 {
+		t.Errorf("expected records 1 and 6, got %v", ids)
+	}
+	fields := []string{"[1].id", "[2]", "[3].Check", "[4].extra"}
+	if len(errs) != len(fields) {
+		t.Fatalf("expected %d errors, got %v", len(fields), errs)
+	}
+	for i, field := range fields {
+		expectBindErrorField(t, errs[i], nil, field)
+	}
+}
+
+func TestStream_JSONArrayLimits(t *testing.T) {
+	body := "[" + strings.TrimSuffix(strings.Repeat(`{"id":1,"name":"a"},`, 5), ",") + "]"
+	ids, errs := collectStream(t, bind.Stream[StreamRecord](newBodyRequest("application/json", body), bind.WithMaxStreamRecords(3)))
+	if len(ids) != 3 || len(errs) != 1 {
+		t.Fatalf("expected 3 records and one error, got %v and %v", ids, errs)
+	}
+	expectBindErrorField(t, errs[0], bind.ErrTooManyRecords, "[3]")
+
+	ids, errs = collectStream(t, bind.Stream[StreamRecord](newBodyRequest("application/json", `[{"id":1,"name":"a"},{"id":2,`)))
+	if len(ids) != 1 || len(errs) != 1 {
+		t.Errorf("expected iteration to end at the syntax error, got %v and %v", ids, errs)
+	}
+
+	_, errs = collectStream(t, bind.Stream[StreamRecord](newBodyRequest("application/json", `{"id":1}`)))
+	if len(errs) != 1 {
+		t.Errorf("expected a single error for a non-array body, got %v", errs)
+	}
+
+	ids, errs = collectStream(t, bind.Stream[StreamRecord](newBodyRequest("application/x-ndjson", strings.Repeat(`{"id":1,"name":"a"}`+"\n", 5)), bind.WithMaxStreamRecords(2)))
+	if len(ids) != 2 || len(errs) != 1 || !errors.Is(errs[0], bind.ErrTooManyRecords) {
+		t.Errorf("expected 2 NDJSON records and ErrTooManyRecords, got %v and %v", ids, errs)
+	}
+}
+
+const priceCSV = "\ufeffsku,price,stock,active,updated_at,note\n" +
+	"A-1,9.99,3,true,2026-01-02T03:04:05Z,x\n" +
+	"\"B,2\",10,,false,,\n"
+
+func TestAction_CSVBinding(t *testing.T) {
+	var edits PriceEdits
+	if err := bind.Action(newBodyRequest("text/csv; charset=utf-8", priceCSV), &edits); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(edits) != 2 {
+		t.Fatalf("expected 2 rows, got %+v", edits)
+	}
+	first, second := edits[0], edits[1]
+	if first.SKU != "A-1" || first.Price != 9.99 || first.Stock == nil || *first.Stock != 3 || !first.Active || first.Note != "" {
+		t.Errorf("unexpected first row: %+v", first)
+	}
+	if first.UpdatedAt == nil || !first.UpdatedAt.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected timestamp: %v", first.UpdatedAt)
+	}
+	if second.SKU != "B,2" || second.Stock != nil || second.UpdatedAt != nil {
+		t.Errorf("unexpected second row: %+v", second)
+	}
+}
+
+func TestAction_CSVErrors(t *testing.T) {
+	tests := []struct {
+		name, body, field string
+		line              int
+		opts              []bind.Option
+	}{
+		{"conversion", "sku,price\nA,1\nB,cheap\n", "[1].price", 3, nil},
+		{"row bind", "sku,price\nA,1\nB,2\nC,-1\n", "[2]", 4, nil},
+		{"field count", "sku,price\nA,1,extra\n", "[0]", 2, nil},
+		{"unknown column", "sku,colour\nA,red\n", "colour", 1, []bind.Option{bind.WithStrictDecoding(true)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var edits PriceEdits
+			err := bind.Action(newBodyRequest("text/csv", tt.body), &edits, tt.opts...)
+			expectBindErrorField(t, err, nil, tt.field)
+			var bindErr bind.BindError
+			if errors.As(err, &bindErr) && bindErr.Line != tt.line {
+				t.Errorf("expected line %d, got %d (%v)", tt.line, bindErr.Line, err)
+			}
+		})
+	}
+
+	var edits PriceEdits
+	if err := bind.Action(newBodyRequest("text/csv", "sku,colour\nA,red\n"), &edits); err != nil || len(edits) != 1 {
+		t.Errorf("expected unknown column to be ignored, got %v", err)
+	}
+}
+
+func TestStream_CSV(t *testing.T) {
+	body := "sku,price\nA,1\nB,cheap\nC,-1\nD,1,extra\nE,5\n"
+	var skus []string
+	var errs []bind.BindError
+	for rec, err := range bind.Stream[*PriceEdit](newBodyRequest("text/csv", body)) {
+		if err != nil {
+			var bindErr bind.BindError
+			if !errors.As(err, &bindErr) {
+				t.Fatalf("expected BindError, got %v", err)
+			}
+			errs = append(errs, bindErr)
+			continue
+		}
+		skus = append(skus, rec.SKU)
+	}
+	if strings.Join(skus, ",") != "A,E" {
+		t.Errorf("expected rows A and E, got %v", skus)
+	}
+	want := []struct {
+		line  int
+		field string
+	}{{3, "price"}, {4, ""}, {5, ""}}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), errs)
+	}
+	for i, w := range want {
+		if errs[i].Line != w.line || errs[i].Field != w.field {
+			t.Errorf("error %d: expected line %d field %q, got line %d field %q (%v)", i, w.line, w.field, errs[i].Line, errs[i].Field, errs[i])
+		}
+	}
+}
+
+func TestAction_PlainTextBinding(t *testing.T) {
+	var note TextNote
+	if err := bind.Action(newBodyRequest("text/plain; charset=utf-8", "hello, 세계"), &note); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if note != "hello, 세계" {
+		t.Errorf("unexpected note: %q", note)
+	}
+
+	note = ""
+	if err := bind.Action(newBodyRequest("text/plain; charset=iso-8859-1", "caf\xe9"), &note); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if note != "café" {
+		t.Errorf("expected charset conversion, got %q", note)
+	}
+
+	var html TextNote
+	if err := bind.Action(newBodyRequest("text/html", "<p>hi</p>"), &html); err != nil || html != "<p>hi</p>" {
+		t.Errorf("unexpected html binding: %q, %v", html, err)
+	}
+
+	note = ""
+	if err := bind.Action(newBodyRequest("text/plain", ""), &note); err == nil {
+		t.Error("expected Bind to run and reject the empty note")
+	}
+}
+
+func TestAction_OctetStreamBinding(t *testing.T) {
+	data := string([]byte{0x00, 0xff, 0x10})
+	var blob Blob
+	if err := bind.Action(newBodyRequest("application/octet-stream", data), &blob); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(blob) != data {
+		t.Errorf("unexpected blob: %v", blob)
+	}
+
+	tagged := &RawBytesUpload{}
+	if err := bind.Action(newBodyRequest("application/octet-stream", data), tagged); err != nil || string(tagged.Data) != data {
+		t.Errorf("unexpected tagged binding: %v, %v", tagged.Data, err)
+	}
+
+	upload := &RawUpload{}
+	if err := bind.Action(newBodyRequest("application/octet-stream", "streamed"), upload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := io.ReadAll(upload.Body); string(got) != "streamed" {
+		t.Errorf("expected the body reader to be left unread, got %q", got)
+	}
+
+	if err := bind.Action(newBodyRequest("application/octet-stream", data), &TestPayload{}); err == nil {
+		t.Error("expected error for a struct without a body field")
+	}
+}
+
+func TestAction_RawBodyLimits(t *testing.T) {
+	body := strings.Repeat("a", 64)
+	var blob Blob
+	err := bind.Action(newBodyRequest("application/octet-stream", body), &blob, bind.WithMaxBodySize(32))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge from Content-Length, got %v", err)
+	}
+
+	req := newBodyRequest("application/octet-stream", body)
+	req.ContentLength = -1
+	err = bind.Action(req, &blob, bind.WithMaxBodySize(32))
+	if !errors.Is(err, bind.ErrBodyTooLarge) {
+		t.Errorf("expected ErrBodyTooLarge while reading, got %v", err)
+	}
+
+	req = newBodyRequest("application/octet-stream", body)
+	req.ContentLength = -1
+	upload := &RawUpload{}
+	if err := bind.Action(req, upload, bind.WithMaxBodySize(32)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var maxBytesErr *http.MaxBytesError
+	if _, err := io.ReadAll(upload.Body); !errors.As(err, &maxBytesErr) {
+		t.Errorf("expected MaxBytesError when reading past the limit, got %v", err)
+	}
+}
+
+const sseBody = "\ufeff: relay comment\r\n" +
+	"id: 1\r\n" +
+	"event: signup\r\n" +
+	"retry: 1500\r\n" +
+	"data: {\"user\":\"ann\",\r\n" +
+	"data: \"count\":1}\r\n" +
+	"\r\n" +
+	"event: ignored-without-data\n" +
+	"\n" +
+	"data: {\"user\":\"bob\",\"count\":2}\n" +
+	"\n" +
+	"id: 3\n" +
+	"data:{\"user\":\"cy\",\"count\":3}\n" +
+	"\n" +
+	"data: {\"user\":\"cut-off\",\"count\":4}\n"
+
+func TestStream_EventStream(t *testing.T) {
+	var events []bind.Event[SSEPayload]
+	for ev, err := range bind.Stream[bind.Event[SSEPayload]](newBodyRequest("text/event-stream", sseBody)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events = append(events, ev)
+	}
+	// 빈 줄로 끝나지 않은 마지막 이벤트는 전달되지 않습니다.
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %+v", events)
+	}
+	first := events[0]
+	if first.ID != "1" || first.Event != "signup" || first.Retry != 1500*time.Millisecond || first.Data.User != "ann" || first.Data.Count != 1 {
+		t.Errorf("unexpected first event: %+v", first)
+	}
+	if second := events[1]; second.ID != "1" || second.Event != "message" || second.Retry != 0 || second.Data.User != "bob" {
+		t.Errorf("unexpected second event: %+v", second)
+	}
+	if third := events[2]; third.ID != "3" || third.Data.User != "cy" {
+		t.Errorf("unexpected third event: %+v", third)
+	}
+
+	var raw []string
+	for data, err := range bind.Stream[string](newBodyRequest("text/event-stream", "data: a\ndata: b\n\ndata: c\n\n")) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		raw = append(raw, data)
+	}
+	if len(raw) != 2 || raw[0] != "a\nb" || raw[1] != "c" {
+		t.Errorf("unexpected raw events: %q", raw)
+	}
+}
+
+func TestAction_EventStreamBinding(t *testing.T) {
+	var relay SSERelay
+	if err := bind.Action(newBodyRequest("text/event-stream", sseBody), &relay); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(relay) != 3 || relay[2].Data.Count != 3 {
+		t.Errorf("unexpected relay: %+v", relay)
+	}
+
+	body := "data: {\"user\":\"ann\"}\n\n" +
+		"data: {\"user\":\"bob\",\"count\":\"x\"}\n\n" +
+		"\n: comment\ndata: {\"count\":3}\n\n"
+	relay = nil
+	err := bind.Action(newBodyRequest("text/event-stream", body), &relay)
+	expectBindErrorField(t, err, nil, "[1].count")
+	var bindErr bind.BindError
+	if errors.As(err, &bindErr) && bindErr.Line != 3 {
+		t.Errorf("expected line 3, got %d", bindErr.Line)
+	}
+
+	var errs []bind.BindError
+	for _, err := range bind.Stream[*SSEPayload](newBodyRequest("text/event-stream", body)) {
+		if errors.As(err, &bindErr) {
+			errs = append(errs, bindErr)
+		}
+	}
+	if len(errs) != 2 || errs[0].Line != 3 || errs[1].Line != 6 || errs[1].Field != "" {
+		t.Errorf("unexpected stream errors: %+v", errs)
+	}
+}
+
+func TestAction_EventStreamLimits(t *testing.T) {
+	// 한 줄과 한 이벤트의 data 전체가 모두 제한됩니다.
+	long := "data: " + strings.Repeat("a", 2048) + "\n\n"
+	many := strings.Repeat("data: "+strings.Repeat("a", 300)+"\n", 4) + "\n"
+	for _, tc := range []struct {
+		body string
+		line int
+	}{
+		{"data: {\"user\":\"ann\"}\n\n" + long, 3},
+		{"data: {\"user\":\"ann\"}\n\n" + many, 6},
+	} {
+		var relay SSERelay
+		err := bind.Action(newBodyRequest("text/event-stream", tc.body), &relay, bind.WithMaxStreamLineSize(1024))
+		var bindErr bind.BindError
+		if !errors.Is(err, bind.ErrLineTooLong) || !errors.As(err, &bindErr) || bindErr.Line != tc.line {
+			t.Errorf("expected ErrLineTooLong on line %d, got %v", tc.line, err)
+		}
+
+		var errs []error
+		for _, err := range bind.Stream[SSEPayload](newBodyRequest("text/event-stream", tc.body), bind.WithMaxStreamLineSize(1024)) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) != 1 || !errors.Is(errs[0], bind.ErrLineTooLong) || !errors.As(errs[0], &bindErr) || bindErr.Line != tc.line {
+			t.Errorf("expected a single ErrLineTooLong on line %d from Stream, got %v", tc.line, errs)
+		}
+	}
+}
+
+func loadedProfile() ProfilePatch {
+	return ProfilePatch{
+		Name:    "ann",
+		Email:   "ann@example.com",
+		Age:     30,
+		Address: &PatchAddress{City: "Seoul", Zip: "04524"},
+		Tags:    map[string]string{"team": "core", "tier": "gold"},
+		Meta:    map[string]any{"theme": "dark", "beta": true},
+		Version: 7,
+	}
+}
+
+func TestAction_MergePatch(t *testing.T) {
+	profile := loadedProfile()
+	body := `{"email":null,"age":0,"address":{"zip":"06236"},"tags":{"tier":null,"region":"apac"},"meta":{"beta":null}}`
+	if err := bind.Action(newBodyRequest("application/merge-patch+json", body), &profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.Name != "ann" || profile.Email != "" || profile.Age != 0 || profile.Version != 7 || !profile.Bound {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+	if profile.Address == nil || profile.Address.City != "Seoul" || profile.Address.Zip != "06236" {
+		t.Errorf("expected the address to be merged, got %+v", profile.Address)
+	}
+	if len(profile.Tags) != 2 || profile.Tags["team"] != "core" || profile.Tags["region"] != "apac" {
+		t.Errorf("unexpected tags: %v", profile.Tags)
+	}
+	if meta, ok := profile.Meta.(map[string]any); !ok || len(meta) != 1 || meta["theme"] != "dark" {
+		t.Errorf("unexpected meta: %v", profile.Meta)
+	}
+
+	profile = loadedProfile()
+	if err := bind.Action(newBodyRequest("application/merge-patch+json", `{"address":null,"meta":[1,2]}`), &profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.Address != nil || len(profile.Meta.([]any)) != 2 {
+		t.Errorf("expected address removed and meta replaced, got %+v", profile)
+	}
+
+	// 병합 결과에 Binder가 실행됩니다.
+	profile = loadedProfile()
+	if err := bind.Action(newBodyRequest("application/merge-patch+json", `{"name":null}`), &profile); err == nil {
+		t.Error("expected Bind to reject the merged profile")
+	}
+}
+
+func TestAction_MergePatchErrors(t *testing.T) {
+	profile := loadedProfile()
+	err := bind.Action(newBodyRequest("application/merge-patch+json", `{"address":{"zip":6236}}`), &profile)
+	expectBindErrorField(t, err, nil, "address.zip")
+
+	// 병합은 원자적이므로 실패한 패치는 앞서 병합된 멤버도 반영하지 않습니다.
+	profile = loadedProfile()
+	address := profile.Address
+	body := `{"email":"x","tags":{"tier":null},"meta":{"beta":null},"address":{"city":"Busan","zip":6236}}`
+	err = bind.Action(newBodyRequest("application/merge-patch+json", body), &profile)
+	expectBindErrorField(t, err, nil, "address.zip")
+	if !reflect.DeepEqual(profile, loadedProfile()) || profile.Address != address || address.City != "Seoul" {
+		t.Errorf("expected the profile to be unchanged after a failed merge, got %+v (address %+v)", profile, profile.Address)
+	}
+
+	profile = loadedProfile()
+	err = bind.Action(newBodyRequest("application/merge-patch+json", `{"address":{"street":"x"}}`), &profile, bind.WithStrictDecoding(true))
+	expectBindErrorField(t, err, bind.ErrUnknownField, "address.street")
+
+	profile = loadedProfile()
+	if err := bind.Action(newBodyRequest("application/merge-patch+json", `{"nickname":"a"}`), &profile); err != nil {
+		t.Errorf("expected unknown members to be ignored, got %v", err)
+	}
+
+	profile = loadedProfile()
+	err = bind.Action(newBodyRequest("application/merge-patch+json", `{"meta":{"a":{"b":{}}}}`), &profile, bind.WithMaxNestingDepth(3))
+	if !errors.Is(err, bind.ErrMaxDepthExceeded) {
+		t.Errorf("expected ErrMaxDepthExceeded, got %v", err)
+	}
+}
+
+func TestAction_JSONPatchApply(t *testing.T) {
+	profile := loadedProfile()
+	body := `[
+		{"op":"test","path":"/name","value":"ann"},
+		{"op":"replace","path":"/email","value":"ann@example.org"},
+		{"op":"remove","path":"/tags/tier"},
+		{"op":"move","from":"/tags/team","path":"/tags/group"},
+		{"op":"copy","from":"/address/city","path":"/tags/city"},
+		{"op":"add","path":"/meta/langs","value":["go"]},
+		{"op":"add","path":"/meta/langs/0","value":"c"},
+		{"op":"add","path":"/meta/langs/-","value":"rust"},
+		{"op":"remove","path":"/address"}
+	]`
+	if err := bind.Action(newBodyRequest("application/json-patch+json", body), &profile); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.Email != "ann@example.org" || profile.Address != nil || profile.Version != 7 || !profile.Bound {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+	if len(profile.Tags) != 2 || profile.Tags["group"] != "core" || profile.Tags["city"] != "Seoul" {
+		t.Errorf("unexpected tags: %v", profile.Tags)
+	}
+	langs := profile.Meta.(map[string]any)["langs"].([]any)
+	if len(langs) != 3 || langs[0] != "c" || langs[1] != "go" || langs[2] != "rust" {
+		t.Errorf("unexpected langs: %v", langs)
+	}
+}
+
+func TestAction_JSONPatchErrors(t *testing.T) {
+	profile := loadedProfile()
+	err := bind.Action(newBodyRequest("application/json-patch+json",
+		`[{"op":"replace","path":"/email","value":"x"},{"op":"test","path":"/age","value":31}]`), &profile)
+	expectBindErrorField(t, err, bind.ErrPatchTestFailed, "[1]")
+	if profile.Email != "ann@example.com" {
+		t.Errorf("expected the failed patch to leave the profile unchanged, got %+v", profile)
+	}
+
+	// 앞선 연산이 공유된 포인터와 맵을 수정했더라도 실패하면 원래 값에 반영되지 않습니다.
+	profile = loadedProfile()
+	address, tags := profile.Address, profile.Tags
+	err = bind.Action(newBodyRequest("application/json-patch+json",
+		`[{"op":"replace","path":"/address/city","value":"Busan"},{"op":"remove","path":"/tags/tier"},{"op":"add","path":"/meta/beta","value":false},{"op":"test","path":"/age","value":31}]`), &profile)
+	expectBindErrorField(t, err, bind.ErrPatchTestFailed, "[3]")
+	if !reflect.DeepEqual(profile, loadedProfile()) || profile.Address != address || address.City != "Seoul" || tags["tier"] != "gold" {
+		t.Errorf("expected the failed patch to leave the profile unchanged, got %+v (address %+v)", profile, profile.Address)
+	}
+
+	// 경로는 적용하기 전에 모두 검증됩니다.
+	profile = loadedProfile()
+	err = bind.Action(newBodyRequest("application/json-patch+json",
+		`[{"op":"replace","path":"/email","value":"x"},{"op":"add","path":"/nickname","value":"a"}]`), &profile)
+	expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[1].path")
+	if profile.Email != "ann@example.com" {
+		t.Errorf("expected the profile to be untouched, got %+v", profile)
+	}
+
+	profile = loadedProfile()
+	err = bind.Action(newBodyRequest("application/json-patch+json", `[{"op":"replace","path":"/address/zip","value":6236}]`), &profile)
+	expectBindErrorField(t, err, nil, "[0].value")
+
+	profile = loadedProfile()
+	err = bind.Action(newBodyRequest("application/json-patch+json", `[{"op":"remove","path":"/tags/missing"}]`), &profile)
+	expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[0].path")
+
+	// any 아래의 맵은 encoding/json과 같이 정수 키를 지원하고, 그 외 키 타입은 경로를 찾지 못한 것으로 보고합니다.
+	keyed := &KeyedPatch{X: map[int]string{1: "a"}}
+	if err := bind.Action(newBodyRequest("application/json-patch+json", `[{"op":"replace","path":"/x/1","value":"b"}]`), keyed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m, _ := keyed.X.(map[int]string); m[1] != "b" {
+		t.Errorf("expected the integer key to be replaced, got %v", keyed.X)
+	}
+	for _, x := range []any{map[int]string{1: "a"}, map[bool]string{true: "a"}} {
+		err = bind.Action(newBodyRequest("application/json-patch+json", `[{"op":"remove","path":"/x/one/y"}]`), &KeyedPatch{X: x})
+		expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[0].path")
+		err = bind.Action(newBodyRequest("application/json-patch+json", `[{"op":"add","path":"/x/true","value":"b"}]`), &KeyedPatch{X: x})
+		expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[0].path")
+	}
+
+	// JSON Pointer는 대소문자를 구분합니다.
+	profile = loadedProfile()
+	err = bind.Action(newBodyRequest("application/json-patch+json", `[{"op":"copy","from":"/name","path":"/tags/n"},{"op":"replace","path":"/Email","value":"x"}]`), &profile)
+	expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[1].path")
+	err = bind.JSONPatch{{Op: "copy", From: "/Name", Path: "/tags/n"}}.Validate(&profile)
+	expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[0].from")
+}
+
+func TestAction_JSONPatchOperations(t *testing.T) {
+	var patch bind.JSONPatch
+	body := `[{"op":"add","path":"/tags/a~1b","value":"x"},{"op":"copy","from":"","path":"/meta"}]`
+	if err := bind.Action(newBodyRequest("application/json-patch+json", body), &patch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(patch) != 2 || patch[0].Path != "/tags/a~1b" || string(patch[0].Value) != `"x"` || patch[1].From != "" {
+		t.Errorf("unexpected patch: %+v", patch)
+	}
+	if err := patch.Validate(ProfilePatch{}); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+	profile := loadedProfile()
+	if err := patch[:1].Apply(&profile); err != nil || profile.Tags["a/b"] != "x" {
+		t.Errorf("unexpected apply result: %v, %v", profile.Tags, err)
+	}
+	err := bind.JSONPatch{{Op: "add", Path: "/age/x", Value: json.RawMessage("1")}}.Validate(&profile)
+	expectBindErrorField(t, err, bind.ErrPatchPathNotFound, "[0].path")
+
+	for body, field := range map[string]string{
+		`[{"op":"remove"}]`:                                         "[0].path",
+		`[{"op":"test","path":"/a"},{}]`:                            "[0].value",
+		`[{"op":"add","path":"/a","value":1},{"op":"x","path":""}]`: "[1].op",
+		`[{"op":"move","path":"/a"}]`:                               "[0].from",
+		`[{"op":"move","from":"/a","path":"/a/b"}]`:                 "[0].from",
+		`[{"op":"remove","path":"a"}]`:                              "[0].path",
+	} {
+		patch = nil
+		err := bind.Action(newBodyRequest("application/json-patch+json", body), &patch)
+		expectBindErrorField(t, err, bind.ErrInvalidPatchOperation, field)
+	}
+}
+
+func TestAction_FieldPresenceJSON(t *testing.T) {
+	var update AccountUpdate
+	body := `{"age":0,"address":{"city":"Seoul"}}`
+	if err := bind.Action(newBodyRequest("application/json", body), &update); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !update.Has("age") || update.Has("email") || !update.Has("address") || !update.Has("address.city") || update.Has("address.zip") {
+		t.Errorf("unexpected fields: %v", update.Paths())
+	}
+	if strings.Join(update.Checked, ",") != "address,address.city,age" {
+		t.Errorf("expected Bind to see the fields, got %v", update.Checked)
+	}
+
+	update = AccountUpdate{}
+	if err := bind.Action(newBodyRequest("application/json", `{"email":""}`), &update); err == nil {
+		t.Error("expected Bind to reject the cleared email")
+	}
+
+	// encoding/json과 같이 대소문자가 다른 키로 채워진 필드도 JSON 이름으로 기록됩니다.
+	update = AccountUpdate{}
+	if err := bind.Action(newBodyRequest("application/json", `{"Email":"","ADDRESS":{"City":"Seoul"}}`), &update); err == nil {
+		t.Error("expected Bind to reject the cleared email sent as Email")
+	}
+	if !update.Has("email") || !update.Has("address.city") || update.Has("Email") {
+		t.Errorf("expected paths under the JSON names, got %v", update.Paths())
+	}
+
+	update = AccountUpdate{Email: "ann@example.com"}
+	if err := bind.Action(newBodyRequest("application/merge-patch+json", `{"address":null}`), &update); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !update.Has("address") || update.Has("email") || update.Email != "ann@example.com" {
+		t.Errorf("unexpected merge patch fields: %v", update.Paths())
+	}
+}
+
+func TestAction_FieldPresenceForm(t *testing.T) {
+	var update AccountUpdate
+	if err := bind.Action(newBodyRequest("application/x-www-form-urlencoded", "age=0&address.City=Seoul"), &update); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !update.Has("age") || update.Has("email") || !update.Has("address") || update.Address.City != "Seoul" {
+		t.Errorf("unexpected form fields: %v", update.Paths())
+	}
+
+	for _, streaming := range []bool{false, true} {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		writer.WriteField("email", "ann@example.com")
+		part, _ := writer.CreateFormFile("avatar", "a.png")
+		part.Write([]byte("png"))
+		writer.Close()
+		req := newBodyRequest(writer.FormDataContentType(), body.String())
+
+		update = AccountUpdate{}
+		if err := bind.Action(req, &update, bind.WithStreamingMultipart(streaming)); err != nil {
+			t.Fatalf("unexpected error (streaming %v): %v", streaming, err)
+		}
+		if strings.Join(update.Paths(), ",") != "avatar,email" || update.Has("age") || string(update.Avatar) != "png" {
+			t.Errorf("unexpected multipart fields (streaming %v): %v", streaming, update.Paths())
+		}
+	}
+}
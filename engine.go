@@ -0,0 +1,241 @@
+package bind
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+)
+
+// DefaultMaxRecursionDepth - 기본 최대 재귀 깊이
+// DefaultMaxRecursionDepth - The default maximum recursion depth.
+const DefaultMaxRecursionDepth = 1000
+
+// DefaultMaxMultipartMemory - 멀티파트 폼 파싱 시 메모리에 보관할 기본 최대 바이트 수
+// 이를 초과하는 파일 파트는 임시 파일로 저장됩니다.
+// DefaultMaxMultipartMemory - The default maximum bytes kept in memory when parsing multipart forms.
+// File parts beyond this are stored in temporary files.
+const DefaultMaxMultipartMemory int64 = 32 << 20
+
+// defaultMaxDepth - 전역 최대 재귀 깊이
+// SetMaxRecursionDepth를 통해 동시성 안전하게 변경할 수 있습니다.
+// defaultMaxDepth - The global maximum recursion depth.
+// Can be changed concurrency-safely via SetMaxRecursionDepth.
+var defaultMaxDepth atomic.Int64
+
+// defaultMultipartMemory - 전역 멀티파트 메모리 제한
+// 요청을 처리하는 고루틴과 설정 변경이 동시에 일어나도 안전하도록 원자적으로 접근합니다.
+// defaultMultipartMemory - The global multipart memory limit.
+// Accessed atomically so that configuration changes are safe while requests are being served.
+var defaultMultipartMemory atomic.Int64
+
+// defaultUploadLimits - 전역 멀티파트 업로드 제한
+// defaultUploadLimits - The global multipart upload limits.
+var defaultUploadLimits atomic.Pointer[UploadLimits]
+
+func init() {
+	defaultMaxDepth.Store(DefaultMaxRecursionDepth)
+	defaultMultipartMemory.Store(DefaultMaxMultipartMemory)
+	defaultUploadLimits.Store(&UploadLimits{})
+}
+
+// SetMaxRecursionDepth - 전역 최대 재귀 깊이를 설정합니다.
+// 0 이하의 값은 깊이 제한을 해제합니다. (포인터 순환 감지는 계속 동작합니다.)
+// SetMaxRecursionDepth - Sets the global maximum recursion depth.
+// A value of 0 or less disables the depth limit. (Pointer cycle detection stays active.)
+func SetMaxRecursionDepth(n int) {
+	defaultMaxDepth.Store(int64(n))
+}
+
+// GetMaxRecursionDepth - 현재 전역 최대 재귀 깊이를 반환합니다.
+// GetMaxRecursionDepth - Returns the current global maximum recursion depth.
+func GetMaxRecursionDepth() int {
+	return int(defaultMaxDepth.Load())
+}
+
+// SetMaxMultipartMemory - 전역 멀티파트 메모리 제한을 설정합니다.
+// SetMaxMultipartMemory - Sets the global multipart memory limit.
+func SetMaxMultipartMemory(size int64) {
+	defaultMultipartMemory.Store(size)
+}
+
+// GetMaxMultipartMemory - 현재 전역 멀티파트 메모리 제한을 반환합니다.
+// GetMaxMultipartMemory - Returns the current global multipart memory limit.
+func GetMaxMultipartMemory() int64 {
+	return defaultMultipartMemory.Load()
+}
+
+// SetUploadLimits - 전역 멀티파트 업로드 제한을 설정합니다.
+// SetUploadLimits - Sets the global multipart upload limits.
+func SetUploadLimits(limits UploadLimits) {
+	defaultUploadLimits.Store(&limits)
+}
+
+// GetUploadLimits - 현재 전역 멀티파트 업로드 제한을 반환합니다.
+// GetUploadLimits - Returns the current global multipart upload limits.
+func GetUploadLimits() UploadLimits {
+	return *defaultUploadLimits.Load()
+}
+
+// config - 단일 바인딩 호출에 적용되는 설정
+// 전역 기본값 위에 엔진 옵션, 요청 컨텍스트 옵션, 호출 옵션 순서로 덮어써서 만들어집니다.
+// config - The settings applied to a single binding call.
+// Built by layering engine options, request context options and then call options on top of the global defaults.
+type config struct {
+	maxDepth           int
+	maxMultipartMemory int64
+	maxBodySize        int64
+	maxNesting         int
+	uploadLimits       UploadLimits
+	streamMultipart    bool
+	fileStore          FileStore
+	autoCleanup        bool
+	strictFilenames    bool
+	strictDecoding     bool
+	maxStreamRecords   int
+	maxStreamLineSize  int64
+
+	// rollback, cleanup - 바인딩 호출마다 새로 만들어집니다.
+	// rollback은 실패 시 저장된 파일 등을 되돌리고, cleanup은 요청이 끝날 때 임시 자원을 정리합니다.
+	// rollback, cleanup - Created fresh for each binding call.
+	// rollback undoes stored files and the like on failure, cleanup releases temporary resources when the request ends.
+	rollback *cleanupList
+	cleanup  *cleanupList
+}
+
+// Option - 바인딩 설정을 변경하는 함수형 옵션
+// Option - A functional option that modifies the binding settings.
+type Option func(*config)
+
+// WithMaxDepth - 최대 재귀 깊이를 지정합니다. 0 이하의 값은 제한을 해제합니다.
+// WithMaxDepth - Sets the maximum recursion depth. A value of 0 or less disables the limit.
+func WithMaxDepth(n int) Option {
+	return func(c *config) { c.maxDepth = n }
+}
+
+// WithMaxMultipartMemory - 멀티파트 폼 파싱 시 메모리에 보관할 최대 바이트 수를 지정합니다.
+// WithMaxMultipartMemory - Sets the maximum bytes kept in memory when parsing multipart forms.
+func WithMaxMultipartMemory(size int64) Option {
+	return func(c *config) { c.maxMultipartMemory = size }
+}
+
+// WithUploadLimits - 멀티파트 업로드 제한을 지정합니다.
+// WithUploadLimits - Sets the multipart upload limits.
+func WithUploadLimits(limits UploadLimits) Option {
+	return func(c *config) { c.uploadLimits = limits }
+}
+
+// newConfig - 전역 기본값에 주어진 옵션들을 순서대로 적용한 설정을 만듭니다.
+// newConfig - Builds a config by applying the given option sets, in order, to the global defaults.
+func newConfig(optSets ...[]Option) *config {
+	c := &config{
+		maxDepth:           GetMaxRecursionDepth(),
+		maxMultipartMemory: GetMaxMultipartMemory(),
+		maxNesting:         DefaultMaxNestingDepth,
+		uploadLimits:       GetUploadLimits(),
+		maxStreamLineSize:  DefaultMaxStreamLineSize,
+	}
+	for _, opts := range optSets {
+		for _, opt := range opts {
+			if opt != nil {
+				opt(c)
+			}
+		}
+	}
+	return c
+}
+
+// optionsKey, configKey - 컨텍스트 키
+// optionsKey는 ContextWithOptions로 추가된 요청별 옵션을, configKey는 바인딩 호출 동안 확정된 설정을 담습니다.
+// optionsKey, configKey - Context keys.
+// optionsKey holds per-request options added via ContextWithOptions, configKey holds the resolved config during a binding call.
+type (
+	optionsKey struct{}
+	configKey  struct{}
+)
+
+// ContextWithOptions - 요청별 옵션을 담은 컨텍스트를 반환합니다.
+// 미들웨어에서 업로드 엔드포인트에만 더 큰 제한을 주는 등의 용도로 사용합니다.
+// 이미 옵션이 있으면 그 뒤에 추가되며, 엔진 옵션보다 우선하고 호출 옵션보다는 후순위입니다.
+// ContextWithOptions - Returns a context carrying per-request options.
+// Useful in middleware, e.g. to give upload endpoints larger limits than the rest of the API.
+// Options are appended to any already present; they override engine options and are overridden by call options.
+func ContextWithOptions(ctx context.Context, opts ...Option) context.Context {
+	prev, _ := ctx.Value(optionsKey{}).([]Option)
+	merged := make([]Option, 0, len(prev)+len(opts))
+	merged = append(append(merged, prev...), opts...)
+	return context.WithValue(ctx, optionsKey{}, merged)
+}
+
+// configFrom - 요청에 적용될 설정을 반환합니다.
+// 바인딩 호출 중이면 확정된 설정을, 아니면 전역 기본값에 컨텍스트 옵션을 적용한 설정을 반환합니다.
+// configFrom - Returns the config that applies to the request.
+// Returns the resolved config during a binding call, or the global defaults with context options applied otherwise.
+func configFrom(r *http.Request) *config {
+	ctx := r.Context()
+	if c, ok := ctx.Value(configKey{}).(*config); ok {
+		return c
+	}
+	ctxOpts, _ := ctx.Value(optionsKey{}).([]Option)
+	return newConfig(ctxOpts)
+}
+
+// Engine - 독립적인 설정을 가진 바인딩 엔진
+// 서로 다른 제한이 필요한 라우터 그룹마다 별도의 엔진을 만들어 사용할 수 있습니다.
+// 엔진 옵션은 호출 시점의 전역 기본값 위에 적용되므로, 지정하지 않은 설정은 전역 값을 따릅니다.
+// Engine - A binding engine with its own settings.
+// Create separate engines for router groups that need different limits.
+// Engine options are applied on top of the global defaults at call time, so unset settings follow the globals.
+type Engine struct {
+	opts []Option
+}
+
+// New - 주어진 옵션으로 새 엔진을 생성합니다.
+// New - Creates a new engine with the given options.
+func New(opts ...Option) *Engine {
+	return &Engine{opts: append([]Option(nil), opts...)}
+}
+
+// defaultEngine - 패키지 수준 Action이 사용하는 엔진
+// defaultEngine - The engine used by the package-level Action.
+var defaultEngine = New()
+
+// Action - 엔진 설정으로 요청을 바인딩합니다. opts는 이 호출에만 적용됩니다.
+// Action - Binds the request using the engine settings. opts apply to this call only.
+func (e *Engine) Action(r *http.Request, v Binder, opts ...Option) error {
+	_, err := e.action(r, v, opts)
+	return err
+}
+
+// action - 바인딩을 수행하고 이 호출에 사용된 설정을 반환합니다.
+// action - Performs the binding and returns the config used for this call.
+func (e *Engine) action(r *http.Request, v Binder, opts []Option) (*config, error) {
+	ctx := r.Context()
+	ctxOpts, _ := ctx.Value(optionsKey{}).([]Option)
+	cfg := newConfig(e.opts, ctxOpts, opts)
+	cfg.rollback = &cleanupList{}
+	cfg.cleanup = &cleanupList{}
+	registerCleanup(ctx, cfg)
+
+	// 디코더가 configFrom으로 설정을 읽을 수 있도록 컨텍스트에 담아 전달합니다.
+	rc := r.WithContext(context.WithValue(ctx, configKey{}, cfg))
+	err := getDecode()(rc, v)
+	// 파싱된 폼은 복사본에 저장되므로, 핸들러가 계속 사용할 수 있도록 원본 요청에 되돌려 놓습니다.
+	r.Form, r.PostForm, r.MultipartForm = rc.Form, rc.PostForm, rc.MultipartForm
+	if err != nil {
+		cfg.rollback.run()
+		var bindErr BindError
+		if errors.As(err, &bindErr) {
+			return cfg, err // 필드 정보를 가진 디코더 에러는 그대로 반환
+		}
+		return cfg, BindError{Err: err}
+	}
+	// 최상위 호출이므로 parentField는 비워두고, depth는 0에서 시작합니다.
+	b := &binding{r: r, maxDepth: cfg.maxDepth}
+	if err := b.bind(reflect.ValueOf(v), "", 0); err != nil {
+		cfg.rollback.run()
+		return cfg, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,163 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// Fields - 요청 본문에 실제로 있던 필드 경로의 집합
+// 바인딩 대상 구조체에 Fields 타입의 필드를 두면(보통 임베드) JSON, JSON Merge Patch, 폼, 멀티파트 본문을 디코딩할 때 채워지므로,
+// 부분 수정에서 "필드가 없음"과 "0 값으로 보냄"을 구분할 수 있습니다. 경로는 JSON이면 "address.city", "items[0].name"처럼
+// 에러 경로와 같은 형식이며 멤버는 연결된 필드의 JSON 이름으로 기록되고, 폼과 멀티파트이면 보낸 키 그대로이며, 두 경우 모두 상위 경로("address")도 포함합니다.
+// 최상위 구조체의 Fields만 채워지며, 루트의 Bind 메서드가 호출될 때는 이미 채워져 있습니다.
+// Fields - The set of field paths actually present in the request body.
+// A field of type Fields (usually embedded) in the bound struct is populated when decoding JSON, JSON Merge Patch, form and multipart bodies,
+// so partial updates can tell "field absent" from "field sent as the zero value". For JSON, paths use the same form as error paths,
+// as in "address.city" or "items[0].name", with members recorded under the matched field's JSON name; for form and multipart they are the keys as sent. Parent paths ("address") are included in both cases.
+// Only the top-level struct's Fields is populated, and it is already populated when the root's Bind method is called.
+type Fields struct {
+	paths map[string]struct{}
+}
+
+// Has - 경로가 요청 본문에 있었는지 반환합니다.
+// Has - Reports whether the path was present in the request body.
+func (f Fields) Has(path string) bool {
+	_, ok := f.paths[path]
+	return ok
+}
+
+// Paths - 요청 본문에 있던 모든 경로를 정렬해 반환합니다.
+// Paths - Returns all paths present in the request body, sorted.
+func (f Fields) Paths() []string {
+	paths := make([]string, 0, len(f.paths))
+	for p := range f.paths {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// addKey - 폼 키와 그 상위 경로를 추가합니다. "a.b[0].c"는 "a", "a.b", "a.b[0]", "a.b[0].c"를 추가합니다.
+// addKey - Adds a form key and its parent paths. "a.b[0].c" adds "a", "a.b", "a.b[0]" and "a.b[0].c".
+func (f Fields) addKey(key string) {
+	for i := 1; i < len(key); i++ {
+		if key[i] == '.' || key[i] == '[' {
+			f.paths[key[:i]] = struct{}{}
+		}
+	}
+	f.paths[key] = struct{}{}
+}
+
+var fieldsType = reflect.TypeOf(Fields{})
+
+// fieldsIndexCache - 구조체 타입별 Fields 필드 인덱스 캐시 (없으면 -1)
+// fieldsIndexCache - A cache of the Fields field index per struct type (-1 if there is none).
+var fieldsIndexCache = &sync.Map{}
+
+// fieldsTarget - v가 가리키는 구조체의 Fields 필드를 반환합니다. 없으면 유효하지 않은 값을 반환합니다.
+// fieldsTarget - Returns the Fields field of the struct v points to. Returns an invalid value if there is none.
+func fieldsTarget(v any) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	rv = rv.Elem()
+	index := -1
+	if cached, ok := fieldsIndexCache.Load(rv.Type()); ok {
+		index = cached.(int)
+	} else {
+		for i := 0; i < rv.NumField(); i++ {
+			if sf := rv.Type().Field(i); sf.IsExported() && sf.Type == fieldsType {
+				index = i
+				break
+			}
+		}
+		fieldsIndexCache.Store(rv.Type(), index)
+	}
+	if index < 0 {
+		return reflect.Value{}
+	}
+	return rv.Field(index)
+}
+
+// formFields - 폼 키 목록으로 Fields를 만듭니다.
+// formFields - Builds Fields from form keys.
+func formFields(keySets ...iter.Seq[string]) Fields {
+	f := Fields{paths: map[string]struct{}{}}
+	for _, keys := range keySets {
+		for key := range keys {
+			f.addKey(key)
+		}
+	}
+	return f
+}
+
+// sentJSONFields - JSON 문서의 모든 객체 멤버와 배열 요소의 경로로 Fields를 만듭니다.
+// 문서를 대상 타입 rt와 함께 한 번 훑으며, 구조체 필드에 연결되는 멤버는 보낸 키가 아닌 필드의 JSON 이름으로 기록합니다.
+// sentJSONFields - Builds Fields from the paths of every object member and array element in a JSON document.
+// It scans the document once alongside the target type rt, recording members that map to struct fields under the field's JSON name rather than the key as sent.
+func sentJSONFields(data []byte, rt reflect.Type) Fields {
+	f := Fields{paths: map[string]struct{}{}}
+	addJSONPaths(f, json.NewDecoder(bytes.NewReader(data)), rt, "")
+	return f
+}
+
+// addJSONPaths - 디코더의 다음 JSON 값 아래의 경로를 재귀적으로 추가합니다. rt는 그 값이 디코딩될 타입이며, 알 수 없으면 nil입니다.
+// addJSONPaths - Recursively adds the paths below the decoder's next JSON value. rt is the type the value decodes into, or nil if unknown.
+func addJSONPaths(f Fields, dec *json.Decoder, rt reflect.Type, path string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	for rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt != nil && reflect.PointerTo(rt).Implements(jsonUnmarshalerType) {
+		rt = nil
+	}
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := keyTok.(string)
+			var elem reflect.Type
+			switch {
+			case rt == nil:
+			case rt.Kind() == reflect.Struct:
+				if field := getJSONFields(rt).lookup(key); field != nil {
+					key, elem = field.name, rt.FieldByIndex(field.index).Type
+				}
+			case rt.Kind() == reflect.Map:
+				elem = rt.Elem()
+			}
+			p := joinPath(path, key)
+			f.paths[p] = struct{}{}
+			if err := addJSONPaths(f, dec, elem, p); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+	case json.Delim('['):
+		var elem reflect.Type
+		if rt != nil && (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) {
+			elem = rt.Elem()
+		}
+		for i := 0; dec.More(); i++ {
+			p := path + "[" + strconv.Itoa(i) + "]"
+			f.paths[p] = struct{}{}
+			if err := addJSONPaths(f, dec, elem, p); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+	}
+	return err
+}
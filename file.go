@@ -0,0 +1,123 @@
+package bind
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"reflect"
+	"sync"
+)
+
+// File - 업로드된 파일에 대한 정보와 내용을 여는 함수를 담은 바인딩 대상 타입
+// *File 또는 []*File 필드에 바인딩됩니다. 내용은 필요할 때 Open으로 엽니다.
+// File - A binding target type carrying information about an uploaded file and a way to open its content.
+// Bound into *File or []*File fields. The content is opened on demand with Open.
+type File struct {
+	// Filename - 클라이언트가 보낸 원본 파일 이름 (신뢰할 수 없는 값입니다)
+	// Filename - The original filename sent by the client (untrusted).
+	Filename string
+	// Size - 파일 크기 (바이트)
+	// Size - The file size in bytes.
+	Size int64
+	// Header - 파트의 MIME 헤더
+	// Header - The MIME header of the part.
+	Header textproto.MIMEHeader
+	// Checksum - `file:"checksum=..."` 옵션으로 검증한 다이제스트 (16진수, 옵션이 없으면 빈 문자열)
+	// Checksum - The digest verified by the `file:"checksum=..."` option (hex, empty without the option).
+	Checksum string
+
+	fh *multipart.FileHeader
+}
+
+// newFile - 파싱된 업로드 파일로부터 File을 만듭니다.
+// newFile - Creates a File from a parsed uploaded file.
+func newFile(fh *multipart.FileHeader) *File {
+	return &File{Filename: fh.Filename, Size: fh.Size, Header: fh.Header, fh: fh}
+}
+
+// ContentType - 파트에 선언된 Content-Type을 반환합니다.
+// ContentType - Returns the Content-Type declared on the part.
+func (f *File) ContentType() string {
+	return f.Header.Get("Content-Type")
+}
+
+// SafeFilename - SanitizeFilename으로 정리한, 저장에 사용할 수 있는 파일 이름을 반환합니다.
+// SafeFilename - Returns the filename cleaned up by SanitizeFilename, suitable for storage.
+func (f *File) SafeFilename() string {
+	return SanitizeFilename(f.Filename)
+}
+
+// Open - 파일 내용을 엽니다. 반환된 파일은 호출자가 닫아야 합니다.
+// Open - Opens the file content. The caller must close the returned file.
+func (f *File) Open() (multipart.File, error) {
+	return f.fh.Open()
+}
+
+// FileHeader - 원본 *multipart.FileHeader를 반환합니다.
+// FileHeader - Returns the underlying *multipart.FileHeader.
+func (f *File) FileHeader() *multipart.FileHeader {
+	return f.fh
+}
+
+var (
+	filePtrType       = reflect.TypeOf((*File)(nil))
+	fileSliceType     = reflect.TypeOf(([]*File)(nil))
+	bytesType         = reflect.TypeOf([]byte(nil))
+	readCloserType    = reflect.TypeOf((*io.ReadCloser)(nil)).Elem()
+	multipartFileType = reflect.TypeOf((*multipart.File)(nil)).Elem()
+)
+
+// openTracked - 업로드 파일을 열고, 요청 컨텍스트가 끝나거나 Cleanup이 호출될 때 닫히도록 등록합니다.
+// openTracked - Opens an uploaded file and arranges for it to be closed when the request context ends or Cleanup is called.
+func openTracked(ctx context.Context, cfg *config, fh *multipart.FileHeader) (multipart.File, error) {
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	closeFile := sync.OnceValue(f.Close)
+	stop := context.AfterFunc(ctx, func() { closeFile() })
+	// 먼저 닫힌 경우 컨텍스트에 등록된 콜백을 해제해, 오래 사는 컨텍스트에 콜백이 쌓이지 않게 합니다.
+	closeOnce := func() error {
+		stop()
+		return closeFile()
+	}
+	cfg.rollback.add(closeOnce)
+	cfg.cleanup.add(closeOnce)
+	return f, nil
+}
+
+// bytesLimit - []byte 필드로 읽어 들일 최대 바이트 수를 결정합니다.
+// 필드 태그, 전역 파일 크기 제한, 멀티파트 메모리 제한 순으로 적용합니다.
+// bytesLimit - Determines the maximum number of bytes read into a []byte field.
+// Uses the field tag, then the global file size limit, then the multipart memory limit.
+func bytesLimit(cfg *config, opts fileOptions) int64 {
+	switch {
+	case opts.maxSize > 0:
+		return opts.maxSize
+	case cfg.uploadLimits.MaxFileSize > 0:
+		return cfg.uploadLimits.MaxFileSize
+	default:
+		return cfg.maxMultipartMemory
+	}
+}
+
+// readFileBytes - 업로드 파일 내용을 크기 제한 안에서 모두 읽습니다.
+// readFileBytes - Reads the whole uploaded file content within the size limit.
+func readFileBytes(fh *multipart.FileHeader, limit int64) ([]byte, error) {
+	if fh.Size > limit {
+		return nil, fmt.Errorf("%w: %q is %d bytes, limit is %d", ErrFileTooLarge, fh.Filename, fh.Size, limit)
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := bytes.NewBuffer(make([]byte, 0, fh.Size))
+	if _, err := io.Copy(buf, f); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,266 @@
+package bind
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// maxFileIndex - 파일 키의 슬라이스 인덱스 상한
+// go-playground/form의 기본 maxArraySize와 같은 값으로, 클라이언트가 거대한 슬라이스를 할당하게 만드는 것을 막습니다.
+// maxFileIndex - The upper bound for slice indices in file keys.
+// Matches go-playground/form's default maxArraySize and stops clients from forcing huge slice allocations.
+const maxFileIndex = 10000
+
+// fileField - 파일 바인딩 대상 필드에 대한 캐시된 정보
+// fileField - Cached information about a file binding target field.
+type fileField struct {
+	index []int
+	name  string
+	opts  fileOptions
+}
+
+// options - 호출 설정을 반영한 필드 옵션을 반환합니다.
+// options - Returns the field options with the call settings applied.
+func (f *fileField) options(cfg *config) fileOptions {
+	opts := f.opts
+	opts.strictName = opts.strictName || cfg.strictFilenames
+	return opts
+}
+
+// nestedField - 파일 필드를 담을 수 있는 중첩 구조체 필드에 대한 캐시된 정보
+// 구조체, 구조체 포인터, 구조체(또는 포인터) 슬라이스를 가리킵니다.
+// nestedField - Cached information about a nested struct field that may hold file fields.
+// Refers to a struct, a struct pointer, or a slice of structs (or struct pointers).
+type nestedField struct {
+	index []int
+	name  string
+	elem  reflect.Type
+	slice bool
+}
+
+// filePlan - 구조체 타입별 파일 바인딩 계획
+// filePlan - The file binding plan for a struct type.
+type filePlan struct {
+	fields []fileField
+	nested []nestedField
+	err    error
+}
+
+// filePlanCache - 구조체 타입별 파일 바인딩 계획 캐시
+// binderCache와 마찬가지로 "write-once, read-many" 시나리오이므로 sync.Map을 사용합니다.
+// filePlanCache - A cache of file binding plans per struct type.
+// Like binderCache, this is a "write-once, read-many" scenario, so sync.Map is used.
+var filePlanCache = &sync.Map{}
+
+// getFilePlan - 구조체 타입의 파일 바인딩 계획을 반환합니다. 태그 오류도 함께 캐싱됩니다.
+// 필드 이름은 go-playground/form과 같이 form 태그, 없으면 Go 필드 이름을 사용합니다.
+// 값으로 임베드된 구조체의 필드는 form과 마찬가지로 평탄화된 이름과 임베드 이름 아래 양쪽으로 접근할 수 있습니다.
+// getFilePlan - Returns the file binding plan of a struct type. Tag errors are cached as well.
+// Like go-playground/form, field names come from the form tag, or the Go field name if there is none.
+// As with form, fields of value-embedded structs are reachable both flattened and under the embedded name.
+func getFilePlan(rt reflect.Type) *filePlan {
+	if cached, ok := filePlanCache.Load(rt); ok {
+		return cached.(*filePlan)
+	}
+	plan := &filePlan{}
+	var embedded []int
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		// 내보내지 않은 필드 중에서는 값으로 임베드된 구조체만 승격된 필드를 통해 설정할 수 있습니다.
+		if !sf.IsExported() && !(sf.Anonymous && sf.Type.Kind() == reflect.Struct) {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("form"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if isFileType(sf.Type) {
+			opts, err := parseFileTag(sf.Tag.Get("file"))
+			if err != nil {
+				plan.err = fmt.Errorf("bind: field %s: %w", sf.Name, err)
+				break
+			}
+			if opts.checksum != "" && sf.Type.Kind() == reflect.Func {
+				plan.err = fmt.Errorf("bind: field %s: checksum is not supported for PartHandler fields", sf.Name)
+				break
+			}
+			plan.fields = append(plan.fields, fileField{index: []int{i}, name: name, opts: opts})
+			continue
+		}
+		if elem, slice, ok := nestedStructType(sf.Type); ok {
+			plan.nested = append(plan.nested, nestedField{index: []int{i}, name: name, elem: elem, slice: slice})
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				embedded = append(embedded, i)
+			}
+		}
+	}
+	// 임베드된 구조체의 필드는 직접 선언된 필드보다 후순위로 평탄화합니다.
+	for _, i := range embedded {
+		if plan.err != nil {
+			break
+		}
+		inner := getFilePlan(rt.Field(i).Type)
+		if inner.err != nil {
+			plan.err = inner.err
+			break
+		}
+		for _, f := range inner.fields {
+			f.index = append([]int{i}, f.index...)
+			plan.fields = append(plan.fields, f)
+		}
+		for _, n := range inner.nested {
+			n.index = append([]int{i}, n.index...)
+			plan.nested = append(plan.nested, n)
+		}
+	}
+	filePlanCache.Store(rt, plan)
+	return plan
+}
+
+// isFileType - 파일 바인딩 대상이 될 수 있는 필드 타입인지 확인합니다.
+// isFileType - Reports whether a field type can be a file binding target.
+func isFileType(t reflect.Type) bool {
+	switch t {
+	case fileHeaderPtrType, fileHeaderSliceType, storedFilePtrType, storedFileSliceType, writerType,
+		filePtrType, fileSliceType, bytesType, readCloserType, multipartFileType:
+		return true
+	}
+	return t.Kind() == reflect.Func && t.ConvertibleTo(partHandlerType)
+}
+
+// nestedStructType - 파일 필드를 담을 수 있는 중첩 구조체 타입인지 확인하고 그 구조체 타입을 반환합니다.
+// nestedStructType - Reports whether t is a nested struct type that may hold file fields, and returns the struct type.
+func nestedStructType(t reflect.Type) (elem reflect.Type, slice bool, ok bool) {
+	if t.Kind() == reflect.Slice {
+		t, slice = t.Elem(), true
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t, slice, t.Kind() == reflect.Struct && !isFileType(reflect.PointerTo(t))
+}
+
+// keySegment - "documents[0].file" 같은 폼 키의 한 구간
+// keySegment - One segment of a form key such as "documents[0].file".
+type keySegment struct {
+	name  string
+	index int // 인덱스가 없으면 -1
+}
+
+// parseFileKey - 폼 키를 구간으로 나눕니다. 형식이 올바르지 않으면 false를 반환합니다.
+// parseFileKey - Splits a form key into segments. Returns false if the key is malformed.
+func parseFileKey(key string) ([]keySegment, bool) {
+	parts := strings.Split(key, ".")
+	segs := make([]keySegment, 0, len(parts))
+	for _, p := range parts {
+		seg := keySegment{name: p, index: -1}
+		if i := strings.IndexByte(p, '['); i >= 0 {
+			if !strings.HasSuffix(p, "]") {
+				return nil, false
+			}
+			n, err := strconv.Atoi(p[i+1 : len(p)-1])
+			if err != nil || n < 0 {
+				return nil, false
+			}
+			seg = keySegment{name: p[:i], index: n}
+		}
+		if seg.name == "" {
+			return nil, false
+		}
+		segs = append(segs, seg)
+	}
+	return segs, true
+}
+
+// match - 구간에 해당하는 파일 필드 또는 중첩 필드를 찾습니다.
+// match - Finds the file field or nested field matching a segment.
+func (p *filePlan) match(seg keySegment, last bool) (*fileField, *nestedField) {
+	if last && seg.index < 0 {
+		for i := range p.fields {
+			if p.fields[i].name == seg.name {
+				return &p.fields[i], nil
+			}
+		}
+		return nil, nil
+	}
+	for i := range p.nested {
+		if n := &p.nested[i]; n.name == seg.name && n.slice == (seg.index >= 0) {
+			return nil, n
+		}
+	}
+	return nil, nil
+}
+
+// lookupFileField - 타입 수준에서 키가 가리키는 파일 필드를 찾습니다. 값을 변경하지 않습니다.
+// 해당하는 파일 필드가 없으면 nil을 반환합니다.
+// lookupFileField - Finds, at the type level, the file field a key refers to. Does not modify any value.
+// Returns nil if there is no matching file field.
+func lookupFileField(rt reflect.Type, segs []keySegment) (*fileField, error) {
+	for {
+		plan := getFilePlan(rt)
+		if plan.err != nil {
+			return nil, plan.err
+		}
+		ff, n := plan.match(segs[0], len(segs) == 1)
+		if ff != nil {
+			return ff, nil
+		}
+		if n == nil || len(segs) == 1 {
+			return nil, nil
+		}
+		if segs[0].index > maxFileIndex {
+			return nil, fmt.Errorf("slice index %d exceeds limit of %d", segs[0].index, maxFileIndex)
+		}
+		rt, segs = n.elem, segs[1:]
+	}
+}
+
+// fileFieldValue - 키가 가리키는 파일 필드의 값을 반환합니다.
+// 경로상의 nil 포인터는 할당하고 짧은 슬라이스는 늘립니다. lookupFileField로 먼저 확인한 키에만 사용해야 합니다.
+// fileFieldValue - Returns the value of the file field a key refers to.
+// Allocates nil pointers and grows short slices along the way. Only use on keys already checked with lookupFileField.
+func fileFieldValue(rv reflect.Value, segs []keySegment) reflect.Value {
+	for {
+		ff, n := getFilePlan(rv.Type()).match(segs[0], len(segs) == 1)
+		if ff != nil {
+			return fieldByIndexAlloc(rv, ff.index)
+		}
+		fv := fieldByIndexAlloc(rv, n.index)
+		if n.slice {
+			if i := segs[0].index; fv.Len() <= i {
+				grown := reflect.MakeSlice(fv.Type(), i+1, i+1)
+				reflect.Copy(grown, fv)
+				fv.Set(grown)
+			}
+			fv = fv.Index(segs[0].index)
+		}
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			fv = fv.Elem()
+		}
+		rv, segs = fv, segs[1:]
+	}
+}
+
+// fieldByIndexAlloc - reflect.Value.FieldByIndex와 같지만 경로상의 nil 포인터를 할당합니다.
+// fieldByIndexAlloc - Like reflect.Value.FieldByIndex, but allocates nil pointers along the path.
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
@@ -0,0 +1,117 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxFilenameLength - 정리된 파일 이름의 최대 바이트 수
+// 대부분의 파일 시스템이 허용하는 파일 이름 길이의 상한입니다.
+// MaxFilenameLength - The maximum length of a sanitized filename in bytes.
+// The filename length limit of most file systems.
+const MaxFilenameLength = 255
+
+// ErrUnsafeFilename - 업로드된 파일 이름이 안전하지 않아 거부되었을 때 반환되는 에러
+// ErrUnsafeFilename - Returned when an uploaded filename is rejected as unsafe.
+var ErrUnsafeFilename = errors.New("unsafe filename")
+
+// windowsReserved - Windows에서 확장자와 관계없이 파일 이름으로 쓸 수 없는 장치 이름
+// windowsReserved - Device names that cannot be used as filenames on Windows, regardless of extension.
+var windowsReserved = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// WithStrictFilenames - 안전하지 않은 원본 파일 이름을 가진 업로드를 거부합니다.
+// 필드별로는 `file:"strictname"` 태그로 지정할 수 있습니다.
+// WithStrictFilenames - Rejects uploads whose raw filename is unsafe.
+// Can be set per field with a `file:"strictname"` tag.
+func WithStrictFilenames(enabled bool) Option {
+	return func(c *config) { c.strictFilenames = enabled }
+}
+
+// SanitizeFilename - 클라이언트가 보낸 파일 이름을 저장에 사용할 수 있도록 정리합니다.
+// 1. 유니코드 NFC로 정규화하고 잘못된 UTF-8을 대체 문자로 바꿉니다.
+// 2. '/'와 '\' 모두를 경로 구분자로 보고 마지막 구성 요소만 남깁니다.
+// 3. 제어 문자와 Windows에서 허용되지 않는 문자를 '_'로 바꿉니다.
+// 4. 앞뒤의 공백과 점을 제거하고, Windows 예약 이름 앞에는 '_'를 붙입니다.
+// 5. 확장자를 유지하면서 MaxFilenameLength 바이트로 자릅니다.
+// 남는 것이 없으면 빈 문자열을 반환합니다.
+// SanitizeFilename - Cleans up a client-supplied filename so that it can be used for storage.
+// 1. Normalizes to Unicode NFC and replaces invalid UTF-8 with the replacement character.
+// 2. Treats both '/' and '\' as path separators and keeps only the last element.
+// 3. Replaces control characters and characters not allowed on Windows with '_'.
+// 4. Trims leading and trailing spaces and dots, and prefixes Windows reserved names with '_'.
+// 5. Truncates to MaxFilenameLength bytes while keeping the extension.
+// Returns an empty string if nothing is left.
+func SanitizeFilename(name string) string {
+	name = norm.NFC.String(strings.ToValidUTF8(name, string(utf8.RuneError)))
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || strings.ContainsRune(`<>:"|?*`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, " .")
+	if name == "" {
+		return ""
+	}
+	stem, _, _ := strings.Cut(name, ".")
+	if windowsReserved[strings.ToUpper(strings.TrimSpace(stem))] {
+		name = "_" + name
+	}
+	return truncateFilename(name, MaxFilenameLength)
+}
+
+// truncateFilename - 확장자를 유지하면서 UTF-8 문자 경계에서 파일 이름을 limit 바이트로 자릅니다.
+// 잘린 부분 끝의 공백과 점은 제거하며, 남는 것이 없으면 빈 문자열을 반환합니다.
+// truncateFilename - Truncates a filename to limit bytes at a UTF-8 boundary while keeping the extension.
+// Spaces and dots at the end of the cut part are trimmed, and an empty string is returned if nothing is left.
+func truncateFilename(name string, limit int) string {
+	if len(name) <= limit {
+		return name
+	}
+	ext := filepath.Ext(name)
+	if len(ext) >= limit/2 {
+		ext = ""
+	}
+	stem := name[:len(name)-len(ext)]
+	cut := limit - len(ext)
+	for cut > 0 && !utf8.RuneStart(stem[cut]) {
+		cut--
+	}
+	stem = strings.TrimRight(stem[:cut], " .")
+	if stem == "" {
+		return ""
+	}
+	return stem + ext
+}
+
+// ValidateFilename - 원본 파일 이름이 정리 없이 그대로 사용해도 안전한지 검사합니다.
+// NFC 정규화만으로 달라지는 이름은 안전한 것으로 봅니다.
+// ValidateFilename - Checks whether a raw filename is safe to use as is, without sanitization.
+// Names that differ only by NFC normalization are considered safe.
+func ValidateFilename(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("%w: empty", ErrUnsafeFilename)
+	case !utf8.ValidString(name):
+		return fmt.Errorf("%w: %q is not valid UTF-8", ErrUnsafeFilename, name)
+	case len(name) > MaxFilenameLength:
+		return fmt.Errorf("%w: longer than %d bytes", ErrUnsafeFilename, MaxFilenameLength)
+	}
+	if normalized := norm.NFC.String(name); SanitizeFilename(normalized) != normalized {
+		return fmt.Errorf("%w: %q", ErrUnsafeFilename, name)
+	}
+	return nil
+}
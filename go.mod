@@ -4,4 +4,21 @@ go 1.24.6
 
 require github.com/go-playground/form/v4 v4.2.1
 
+require (
+	golang.org/x/text v0.30.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/fxamacker/cbor/v2 v2.9.1
+	github.com/pelletier/go-toml/v2 v2.2.4
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+	google.golang.org/protobuf v1.36.9
+)
+
+require (
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+)
+
 replace github.com/DevNewbie1826/bind => ./
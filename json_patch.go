@@ -0,0 +1,590 @@
+package bind
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPatchOperation - JSON Patch 연산의 형식이 잘못되었을 때 반환되는 에러
+// ErrInvalidPatchOperation - Returned when a JSON Patch operation is malformed.
+var ErrInvalidPatchOperation = errors.New("invalid patch operation")
+
+// ErrPatchPathNotFound - JSON Patch 연산의 경로가 대상에 없을 때 반환되는 에러
+// ErrPatchPathNotFound - Returned when the path of a JSON Patch operation does not exist in the target.
+var ErrPatchPathNotFound = errors.New("patch path not found")
+
+// ErrPatchTestFailed - JSON Patch의 test 연산이 실패했을 때 반환되는 에러
+// ErrPatchTestFailed - Returned when a test operation of a JSON Patch fails.
+var ErrPatchTestFailed = errors.New("patch test failed")
+
+// PatchOperation - JSON Patch(RFC 6902)의 연산 하나
+// PatchOperation - A single JSON Patch (RFC 6902) operation.
+type PatchOperation struct {
+	// Op - "add", "remove", "replace", "move", "copy", "test" 중 하나
+	// Op - One of "add", "remove", "replace", "move", "copy" or "test".
+	Op string `json:"op"`
+	// Path - 연산 대상 위치를 가리키는 JSON Pointer(RFC 6901)
+	// Path - A JSON Pointer (RFC 6901) to the location the operation targets.
+	Path string `json:"path"`
+	// From - move와 copy 연산의 원본 위치를 가리키는 JSON Pointer
+	// From - A JSON Pointer to the source location of move and copy operations.
+	From string `json:"from,omitempty"`
+	// Value - add, replace, test 연산의 값
+	// Value - The value of add, replace and test operations.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch - JSON Patch(RFC 6902) 연산 목록
+// application/json-patch+json 본문을 JSONPatch(또는 기반 타입이 []PatchOperation인 타입)에 바인딩하면 연산 형식만 검증한 채 담기며,
+// 그 외의 대상에 바인딩하면 대상 타입의 JSON 필드로 경로를 검증한 뒤 연산을 대상에 적용합니다.
+// JSONPatch - A list of JSON Patch (RFC 6902) operations.
+// Binding an application/json-patch+json body into a JSONPatch (or a type whose underlying type is []PatchOperation) stores it with only the operation format checked;
+// binding it into any other target checks the paths against the target type's JSON fields and then applies the operations to the target.
+type JSONPatch []PatchOperation
+
+// Bind - Binder 구현. 연산 형식은 디코딩할 때 검증됩니다.
+// Bind - Implements Binder. The operation format is checked during decoding.
+func (p *JSONPatch) Bind(r *http.Request) error { return nil }
+
+var jsonPatchType = reflect.TypeOf(JSONPatch(nil))
+
+// Validate - 연산의 형식과, 경로가 v의 타입에 존재하는지 검증합니다. v는 값이나 포인터이며, nil이면 형식만 검증합니다.
+// 구조체 필드는 RFC 6901대로 대소문자까지 정확히 일치하는 JSON 이름으로 찾으며, 맵 키와 any 아래의 경로는 적용할 때 확인합니다.
+// 에러는 "[1].path"처럼 연산 인덱스와 멤버 이름을 담은 BindError로 보고됩니다.
+// Validate - Checks the format of the operations and that their paths exist in the type of v. v is a value or a pointer; if nil, only the format is checked.
+// Struct fields are looked up by their JSON names, matched exactly including case as RFC 6901 requires, and paths under map keys and any are checked when applied.
+// Errors are reported as BindErrors carrying the operation index and member name, as in "[1].path".
+func (p JSONPatch) Validate(v any) error {
+	rt := reflect.TypeOf(v)
+	for i, op := range p {
+		if err := op.check(); err != nil {
+			return indexError(err, i)
+		}
+		if rt == nil {
+			continue
+		}
+		if err := validatePatchPath(rt, op.Path, "path", op.Op == "add" || op.Op == "move" || op.Op == "copy"); err != nil {
+			return indexError(err, i)
+		}
+		if op.Op == "move" || op.Op == "copy" {
+			if err := validatePatchPath(rt, op.From, "from", false); err != nil {
+				return indexError(err, i)
+			}
+		}
+	}
+	return nil
+}
+
+// Apply - 연산을 검증한 뒤 순서대로 v에 적용합니다. v는 nil이 아닌 포인터여야 합니다.
+// test 연산은 값을 JSON으로 비교합니다. RFC 6902대로 적용은 원자적입니다. 연산은 v의 깊은 복사본에 적용되고 모두 성공했을 때만 v에 반영되므로, 에러가 나면 v는 바뀌지 않습니다.
+// Apply - Validates the operations and then applies them to v in order. v must be a non-nil pointer.
+// test operations compare values as JSON. As RFC 6902 requires, applying is atomic: the operations work on a deep copy of v that is written back only if all of them succeed, so on error v is left unchanged.
+func (p JSONPatch) Apply(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("bind: JSON patch requires a non-nil pointer, got %T", v)
+	}
+	if err := p.Validate(v); err != nil {
+		return err
+	}
+	patched := reflect.New(rv.Elem().Type()).Elem()
+	patched.Set(deepCopy(rv.Elem(), map[copyKey]reflect.Value{}))
+	for i, op := range p {
+		if err := op.apply(patched); err != nil {
+			return indexError(err, i)
+		}
+	}
+	rv.Elem().Set(patched)
+	return nil
+}
+
+// copyKey - 깊은 복사 중 이미 복사한 포인터와 맵을 찾기 위한 키
+// copyKey - A key for finding pointers and maps already copied during a deep copy.
+type copyKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// deepCopy - 포인터, 맵, 슬라이스, 배열, 인터페이스, 구조체의 내보낸 필드를 재귀적으로 복제한 값을 반환합니다.
+// seen은 같은 포인터나 맵을 한 번만 복제해 공유와 순환을 유지합니다. 내보내지 않은 필드는 그대로 복사됩니다.
+// deepCopy - Returns a copy of v with pointers, maps, slices, arrays, interfaces and the exported fields of structs cloned recursively.
+// seen makes each pointer or map cloned only once, preserving sharing and cycles. Unexported fields are copied as is.
+func deepCopy(v reflect.Value, seen map[copyKey]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		key := copyKey{v.Type(), v.Pointer()}
+		if c, ok := seen[key]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[key] = c
+		c.Elem().Set(deepCopy(v.Elem(), seen))
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		key := copyKey{v.Type(), v.Pointer()}
+		if c, ok := seen[key]; ok {
+			return c
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		seen[key] = c
+		for it := v.MapRange(); it.Next(); {
+			c.SetMapIndex(it.Key(), deepCopy(it.Value(), seen))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem(), seen))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				c.Field(i).Set(deepCopy(v.Field(i), seen))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// check - 연산 종류와 필요한 멤버, JSON Pointer 문법을 검증합니다.
+// check - Checks the operation kind, the required members and the JSON Pointer syntax.
+func (op PatchOperation) check() error {
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return BindError{Field: "value", Err: fmt.Errorf("%w: %s requires a value", ErrInvalidPatchOperation, op.Op)}
+		}
+	case "move", "copy":
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return BindError{Field: "from", Err: err}
+		}
+		path, _ := parsePointer(op.Path)
+		if op.Op == "move" && len(from) < len(path) && slices.Equal(from, path[:len(from)]) {
+			return BindError{Field: "from", Err: fmt.Errorf("%w: cannot move %q into its own child", ErrInvalidPatchOperation, op.From)}
+		}
+	case "remove":
+	default:
+		return BindError{Field: "op", Err: fmt.Errorf("%w: unknown op %q", ErrInvalidPatchOperation, op.Op)}
+	}
+	if _, err := parsePointer(op.Path); err != nil {
+		return BindError{Field: "path", Err: err}
+	}
+	return nil
+}
+
+// apply - 연산 하나를 rv에 적용합니다.
+// apply - Applies a single operation to rv.
+func (op PatchOperation) apply(rv reflect.Value) error {
+	path, _ := parsePointer(op.Path)
+	switch op.Op {
+	case "add", "replace":
+		return patchAt(rv, path, "path", func(container reflect.Value, tok string) error {
+			return patchSet(container, tok, op.Value, op.Op == "add")
+		})
+	case "remove":
+		return patchAt(rv, path, "path", patchRemove)
+	case "move", "copy":
+		from, _ := parsePointer(op.From)
+		var value json.RawMessage
+		err := patchAt(rv, from, "from", func(container reflect.Value, tok string) error {
+			v, err := patchGet(container, tok)
+			if err == nil {
+				value, err = json.Marshal(v.Interface())
+			}
+			if err == nil && op.Op == "move" {
+				err = patchRemove(container, tok)
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return patchAt(rv, path, "path", func(container reflect.Value, tok string) error {
+			return patchSet(container, tok, value, true)
+		})
+	case "test":
+		return patchAt(rv, path, "path", func(container reflect.Value, tok string) error {
+			v, err := patchGet(container, tok)
+			if err != nil {
+				return err
+			}
+			got, err := json.Marshal(v.Interface())
+			if err != nil {
+				return err
+			}
+			var want, have any
+			if json.Unmarshal(op.Value, &want) != nil || json.Unmarshal(got, &have) != nil || !reflect.DeepEqual(want, have) {
+				// 실패한 test는 경로가 아닌 연산 전체의 에러로 보고합니다.
+				return BindError{Err: fmt.Errorf("%w: %q", ErrPatchTestFailed, op.Path)}
+			}
+			return nil
+		})
+	}
+	return nil
+}
+
+// parsePointer - JSON Pointer를 토큰 목록으로 나눕니다. 빈 문자열은 문서 전체를 가리킵니다.
+// parsePointer - Splits a JSON Pointer into its reference tokens. The empty string refers to the whole document.
+func parsePointer(p string) ([]string, error) {
+	if p == "" {
+		return nil, nil
+	}
+	if p[0] != '/' {
+		return nil, fmt.Errorf("%w: pointer %q must start with '/'", ErrInvalidPatchOperation, p)
+	}
+	tokens := strings.Split(p[1:], "/")
+	for i, tok := range tokens {
+		if strings.Count(tok, "~") != strings.Count(tok, "~0")+strings.Count(tok, "~1") {
+			return nil, fmt.Errorf("%w: invalid escape in pointer %q", ErrInvalidPatchOperation, p)
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tok, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// validatePatchPath - 경로가 타입 rt에 존재하는지 검증합니다. appendable이면 마지막 토큰으로 슬라이스 끝을 가리키는 "-"를 허용합니다.
+// validatePatchPath - Checks that the path exists in the type rt. If appendable, "-" referring to the end of a slice is allowed as the last token.
+func validatePatchPath(rt reflect.Type, pointer, member string, appendable bool) error {
+	tokens, _ := parsePointer(pointer)
+	for i, tok := range tokens {
+		for rt.Kind() == reflect.Ptr {
+			rt = rt.Elem()
+		}
+		if rt.Kind() == reflect.Interface {
+			return nil
+		}
+		notFound := BindError{Field: member, Err: fmt.Errorf("%w: %q", ErrPatchPathNotFound, pointer)}
+		if reflect.PointerTo(rt).Implements(jsonUnmarshalerType) {
+			return notFound
+		}
+		switch rt.Kind() {
+		case reflect.Struct:
+			f := getJSONFields(rt).exact(tok)
+			if f == nil {
+				return notFound
+			}
+			rt = rt.FieldByIndex(f.index).Type
+		case reflect.Map:
+			if _, err := patchMapKey(rt.Key(), tok); err != nil {
+				return notFound
+			}
+			rt = rt.Elem()
+		case reflect.Slice, reflect.Array:
+			last := i == len(tokens)-1
+			if !(tok == "-" && last && appendable && rt.Kind() == reflect.Slice) {
+				if _, ok := patchIndex(tok); !ok {
+					return notFound
+				}
+			}
+			rt = rt.Elem()
+		default:
+			return notFound
+		}
+	}
+	return nil
+}
+
+// patchIndex - 배열 인덱스 토큰을 파싱합니다. RFC 6901대로 앞에 0이 붙은 인덱스는 거부합니다.
+// patchIndex - Parses an array index token. As RFC 6901 specifies, indexes with leading zeros are rejected.
+func patchIndex(tok string) (int, bool) {
+	if tok == "" || (len(tok) > 1 && tok[0] == '0') {
+		return 0, false
+	}
+	n, err := strconv.Atoi(tok)
+	return n, err == nil && n >= 0
+}
+
+// patchMapKey - 토큰을 키 타입 kt의 맵 키로 변환합니다. encoding/json과 같이 encoding.TextUnmarshaler, 문자열, 정수 키를 지원하며,
+// 그 외의 키 타입이나 변환할 수 없는 토큰이면 ErrPatchPathNotFound를 반환합니다.
+// patchMapKey - Converts a token into a map key of the key type kt. Like encoding/json, it supports encoding.TextUnmarshaler, string and integer keys,
+// and returns ErrPatchPathNotFound for other key types or tokens that cannot be converted.
+func patchMapKey(kt reflect.Type, tok string) (reflect.Value, error) {
+	switch {
+	case reflect.PointerTo(kt).Implements(textUnmarshalerType):
+		key := reflect.New(kt)
+		if key.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(tok)) == nil {
+			return key.Elem(), nil
+		}
+	case kt.Kind() == reflect.String:
+		return reflect.ValueOf(tok).Convert(kt), nil
+	case kt.Kind() >= reflect.Int && kt.Kind() <= reflect.Int64:
+		if n, err := strconv.ParseInt(tok, 10, 64); err == nil && !reflect.Zero(kt).OverflowInt(n) {
+			return reflect.ValueOf(n).Convert(kt), nil
+		}
+	case kt.Kind() >= reflect.Uint && kt.Kind() <= reflect.Uintptr:
+		if n, err := strconv.ParseUint(tok, 10, 64); err == nil && !reflect.Zero(kt).OverflowUint(n) {
+			return reflect.ValueOf(n).Convert(kt), nil
+		}
+	}
+	return reflect.Value{}, ErrPatchPathNotFound
+}
+
+// patchAt - 경로의 마지막 토큰을 담은 컨테이너까지 내려가 fn을 호출합니다.
+// 맵 값과 any에 담긴 값은 주소를 얻을 수 없으므로 복사본에 fn을 적용한 뒤 다시 설정합니다. 빈 경로는 문서 전체를 가리킵니다.
+// patchAt - Descends to the container holding the last token of the path and calls fn.
+// Map values and values held in any are not addressable, so fn is applied to a copy that is then set back. An empty path refers to the whole document.
+func patchAt(rv reflect.Value, path []string, member string, fn func(container reflect.Value, tok string) error) error {
+	if len(path) == 0 {
+		// 문서 전체는 값 하나를 담은 컨테이너처럼 다룹니다.
+		root := reflect.New(reflect.ArrayOf(1, rv.Type())).Elem()
+		root.Index(0).Set(rv)
+		if err := fn(root, "0"); err != nil {
+			return withMember(err, member)
+		}
+		rv.Set(root.Index(0))
+		return nil
+	}
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return BindError{Field: member, Err: ErrPatchPathNotFound}
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return BindError{Field: member, Err: ErrPatchPathNotFound}
+		}
+		c := reflect.New(rv.Elem().Type()).Elem()
+		c.Set(rv.Elem())
+		if err := patchAt(c, path, member, fn); err != nil {
+			return err
+		}
+		rv.Set(c)
+		return nil
+	}
+	if len(path) == 1 {
+		return withMember(fn(rv, path[0]), member)
+	}
+	tok, rest := path[0], path[1:]
+	switch rv.Kind() {
+	case reflect.Map:
+		key, err := patchMapKey(rv.Type().Key(), tok)
+		if err != nil {
+			return BindError{Field: member, Err: err}
+		}
+		existing := rv.MapIndex(key)
+		if !existing.IsValid() {
+			return BindError{Field: member, Err: ErrPatchPathNotFound}
+		}
+		c := reflect.New(existing.Type()).Elem()
+		c.Set(existing)
+		if err := patchAt(c, rest, member, fn); err != nil {
+			return err
+		}
+		rv.SetMapIndex(key, c)
+		return nil
+	default:
+		child, err := patchGet(rv, tok)
+		if err != nil {
+			return withMember(err, member)
+		}
+		return patchAt(child, rest, member, fn)
+	}
+}
+
+// withMember - 필드 정보가 없는 에러를 연산 멤버 이름을 담은 BindError로 감쌉니다.
+// withMember - Wraps an error without field information into a BindError carrying the operation member name.
+func withMember(err error, member string) error {
+	if err == nil {
+		return nil
+	}
+	var bindErr BindError
+	if errors.As(err, &bindErr) {
+		return err
+	}
+	return BindError{Field: member, Err: err}
+}
+
+// patchGet - 컨테이너에서 토큰이 가리키는 값을 반환합니다.
+// patchGet - Returns the value the token refers to in a container.
+func patchGet(rv reflect.Value, tok string) (reflect.Value, error) {
+	switch rv.Kind() {
+	case reflect.Struct:
+		if f := getJSONFields(rv.Type()).exact(tok); f != nil {
+			return fieldByIndexAlloc(rv, f.index), nil
+		}
+	case reflect.Map:
+		key, err := patchMapKey(rv.Type().Key(), tok)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if v := rv.MapIndex(key); v.IsValid() {
+			return v, nil
+		}
+	case reflect.Slice, reflect.Array:
+		if i, ok := patchIndex(tok); ok && i < rv.Len() {
+			return rv.Index(i), nil
+		}
+	}
+	return reflect.Value{}, ErrPatchPathNotFound
+}
+
+// patchSet - 컨테이너의 토큰 위치에 JSON 값을 디코딩해 설정합니다.
+// add이면 맵에 새 키를, 슬라이스에 새 요소를 끼워 넣고, 아니면 기존 위치만 교체합니다.
+// patchSet - Decodes a JSON value and sets it at the token's location in a container.
+// With add, a new key is inserted into a map or a new element into a slice; otherwise only an existing location is replaced.
+func patchSet(rv reflect.Value, tok string, raw json.RawMessage, add bool) error {
+	var elemType reflect.Type
+	switch rv.Kind() {
+	case reflect.Struct:
+		f := getJSONFields(rv.Type()).exact(tok)
+		if f == nil {
+			return ErrPatchPathNotFound
+		}
+		elemType = rv.Type().FieldByIndex(f.index).Type
+	case reflect.Map, reflect.Slice, reflect.Array:
+		elemType = rv.Type().Elem()
+	default:
+		return ErrPatchPathNotFound
+	}
+	nv := reflect.New(elemType)
+	if err := json.Unmarshal(raw, nv.Interface()); err != nil {
+		return pathError(jsonError(err), "value")
+	}
+	value := nv.Elem()
+
+	switch rv.Kind() {
+	case reflect.Map:
+		key, err := patchMapKey(rv.Type().Key(), tok)
+		if err != nil {
+			return err
+		}
+		if !add && !rv.MapIndex(key).IsValid() {
+			return ErrPatchPathNotFound
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+		rv.SetMapIndex(key, value)
+		return nil
+	case reflect.Slice:
+		if add {
+			i, ok := patchIndex(tok)
+			if tok == "-" {
+				i, ok = rv.Len(), true
+			}
+			if !ok || i > rv.Len() {
+				return ErrPatchPathNotFound
+			}
+			n := reflect.MakeSlice(rv.Type(), 0, rv.Len()+1)
+			n = reflect.AppendSlice(n, rv.Slice(0, i))
+			n = reflect.Append(n, value)
+			rv.Set(reflect.AppendSlice(n, rv.Slice(i, rv.Len())))
+			return nil
+		}
+	}
+	target, err := patchGet(rv, tok)
+	if err != nil {
+		return err
+	}
+	target.Set(value)
+	return nil
+}
+
+// patchRemove - 컨테이너에서 토큰 위치의 값을 제거합니다. 구조체 필드는 0 값으로 되돌립니다.
+// patchRemove - Removes the value at the token's location from a container. Struct fields are reset to their zero value.
+func patchRemove(rv reflect.Value, tok string) error {
+	target, err := patchGet(rv, tok)
+	if err != nil {
+		return err
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		// patchGet이 성공했으므로 키는 이미 변환할 수 있습니다.
+		key, _ := patchMapKey(rv.Type().Key(), tok)
+		rv.SetMapIndex(key, reflect.Value{})
+	case reflect.Slice:
+		i, _ := patchIndex(tok)
+		n := reflect.MakeSlice(rv.Type(), 0, rv.Len()-1)
+		n = reflect.AppendSlice(n, rv.Slice(0, i))
+		rv.Set(reflect.AppendSlice(n, rv.Slice(i+1, rv.Len())))
+	default:
+		target.SetZero()
+	}
+	return nil
+}
+
+// rawPatchOperation - 멤버가 있는지 구분하기 위한 디코딩용 연산
+// rawPatchOperation - An operation used for decoding, telling whether members are present.
+type rawPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// decodeJSONPatchRequest - JSON Patch(RFC 6902) 본문을 디코딩합니다.
+// 대상의 기반 타입이 []PatchOperation이면 연산 목록을 담고, 그 외에는 대상 타입으로 경로를 검증한 뒤 연산을 대상에 적용합니다.
+// 에러는 "[2].path"처럼 연산 인덱스를 담은 BindError로 보고됩니다. 본문 크기 제한과 중첩 깊이 제한이 적용됩니다.
+// decodeJSONPatchRequest - Decodes a JSON Patch (RFC 6902) body.
+// If the target's underlying type is []PatchOperation, it receives the operation list; otherwise the paths are checked against the target type and the operations are applied to the target.
+// Errors are reported as BindErrors carrying the operation index, as in "[2].path". The body size and nesting depth limits apply.
+func decodeJSONPatchRequest(r *http.Request, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("bind: JSON patch requires a non-nil pointer, got %T", v)
+	}
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	var raw []rawPatchOperation
+	if err := json.NewDecoder(newJSONDepthReader(body, cfg.maxNesting)).Decode(&raw); err != nil {
+		return jsonError(err)
+	}
+	patch := make(JSONPatch, len(raw))
+	for i, op := range raw {
+		if op.Path == nil {
+			return indexError(BindError{Field: "path", Err: fmt.Errorf("%w: missing path", ErrInvalidPatchOperation)}, i)
+		}
+		if op.From == nil && (op.Op == "move" || op.Op == "copy") {
+			return indexError(BindError{Field: "from", Err: fmt.Errorf("%w: %s requires from", ErrInvalidPatchOperation, op.Op)}, i)
+		}
+		patch[i] = PatchOperation{Op: op.Op, Path: *op.Path, Value: op.Value}
+		if op.From != nil {
+			patch[i].From = *op.From
+		}
+		if err := patch[i].check(); err != nil {
+			return indexError(err, i)
+		}
+	}
+	if target := rv.Elem(); target.Kind() == reflect.Slice && target.Type().ConvertibleTo(jsonPatchType) {
+		target.Set(reflect.ValueOf(patch).Convert(target.Type()))
+		return nil
+	}
+	return patch.Apply(v)
+}
@@ -0,0 +1,337 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// decodeMergePatchRequest - JSON Merge Patch(RFC 7396) 본문을 호출자가 채워 둔 대상 값에 적용합니다.
+// 객체의 멤버는 같은 이름의 필드나 맵 키에 재귀적으로 병합되고, null은 필드를 0 값으로 되돌리거나 맵 키를 삭제합니다.
+// 객체가 아닌 값과 json.Unmarshaler를 구현한 타입은 통째로 교체됩니다. 병합이 끝난 결과에 Action이 Binder 재귀를 실행합니다.
+// 본문 크기 제한, 중첩 깊이 제한, 엄격한 디코딩이 JSON과 같이 적용됩니다. 병합은 대상의 복사본에서 이루어지고 성공했을 때만 대상에 반영되므로, 에러가 나면 대상은 바뀌지 않습니다.
+// decodeMergePatchRequest - Applies a JSON Merge Patch (RFC 7396) body onto a target value populated by the caller.
+// Object members are merged recursively into the field or map key of the same name, and null resets a field to its zero value or deletes a map key.
+// Non-object values and types implementing json.Unmarshaler are replaced as a whole. Action runs the Binder recursion on the merged result.
+// The body size limit, nesting depth limit and strict decoding apply as for JSON. The merge works on a copy of the target that is written back only on success, so on error the target is left unchanged.
+func decodeMergePatchRequest(r *http.Request, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("bind: merge patch requires a non-nil pointer, got %T", v)
+	}
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	var patch json.RawMessage
+	if err := json.NewDecoder(newJSONDepthReader(body, cfg.maxNesting)).Decode(&patch); err != nil {
+		return jsonError(err)
+	}
+	p := &mergePatcher{strict: cfg.strictDecoding}
+	merged := reflect.New(rv.Elem().Type()).Elem()
+	merged.Set(rv.Elem())
+	if err := p.merge(merged, patch, ""); err != nil {
+		return err
+	}
+	rv.Elem().Set(merged)
+	if fields := fieldsTarget(v); fields.IsValid() {
+		fields.Set(reflect.ValueOf(sentJSONFields(patch, rv.Type())))
+	}
+	return nil
+}
+
+// mergePatcher - 병합 패치를 값에 재귀적으로 적용합니다.
+// 포인터와 맵은 수정하기 전에 복제하므로, 복사본에 병합해도 원래 값이 가리키는 데이터는 바뀌지 않습니다.
+// mergePatcher - Recursively applies a merge patch to a value.
+// Pointers and maps are cloned before they are modified, so merging into a copy leaves the data the original value refers to unchanged.
+type mergePatcher struct {
+	strict bool
+}
+
+// merge - 패치 값 raw를 rv에 병합합니다. path는 에러에 담길 필드 경로입니다.
+// merge - Merges the patch value raw into rv. path is the field path reported in errors.
+func (p *mergePatcher) merge(rv reflect.Value, raw json.RawMessage, path string) error {
+	raw = bytes.TrimSpace(raw)
+	if string(raw) == "null" {
+		rv.SetZero()
+		return nil
+	}
+	if raw[0] != '{' || reflect.PointerTo(rv.Type()).Implements(jsonUnmarshalerType) {
+		return p.replace(rv, raw, path)
+	}
+	switch rv.Kind() {
+	case reflect.Ptr:
+		clonePointer(rv)
+		return p.merge(rv.Elem(), raw, path)
+	case reflect.Struct:
+		return p.mergeStruct(rv, raw, path)
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return p.replace(rv, raw, path)
+		}
+		rv.Set(cloneMap(rv))
+		return p.mergeMap(rv, raw, path)
+	case reflect.Interface:
+		// any에 담긴 JSON 객체(map[string]any)는 복제한 맵에 병합하고, 그 외의 값은 교체합니다.
+		if elem := rv.Elem(); elem.Kind() == reflect.Map && elem.Type().Key().Kind() == reflect.String && !elem.IsNil() {
+			m := cloneMap(elem)
+			if err := p.mergeMap(m, raw, path); err != nil {
+				return err
+			}
+			rv.Set(m)
+			return nil
+		}
+	}
+	return p.replace(rv, raw, path)
+}
+
+// mergeStruct - 패치 객체의 멤버를 JSON 이름이 같은 구조체 필드에 병합합니다.
+// mergeStruct - Merges the members of a patch object into the struct fields with the same JSON names.
+func (p *mergePatcher) mergeStruct(rv reflect.Value, raw json.RawMessage, path string) error {
+	members, err := jsonMembers(raw)
+	if err != nil {
+		return pathError(err, path)
+	}
+	fields := getJSONFields(rv.Type())
+	for _, m := range members {
+		f := fields.lookup(m.key)
+		if f == nil {
+			if p.strict {
+				return BindError{Field: joinPath(path, m.key), Err: fmt.Errorf("%w %q", ErrUnknownField, m.key)}
+			}
+			continue
+		}
+		if err := p.merge(fieldByIndexClone(rv, f.index), m.value, joinPath(path, m.key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeMap - 패치 객체의 멤버를 맵에 병합합니다. null인 멤버는 키를 삭제합니다.
+// mergeMap - Merges the members of a patch object into a map. Null members delete the key.
+func (p *mergePatcher) mergeMap(rv reflect.Value, raw json.RawMessage, path string) error {
+	members, err := jsonMembers(raw)
+	if err != nil {
+		return pathError(err, path)
+	}
+	for _, m := range members {
+		key := reflect.ValueOf(m.key).Convert(rv.Type().Key())
+		if string(bytes.TrimSpace(m.value)) == "null" {
+			rv.SetMapIndex(key, reflect.Value{})
+			continue
+		}
+		ev := reflect.New(rv.Type().Elem()).Elem()
+		if existing := rv.MapIndex(key); existing.IsValid() {
+			ev.Set(existing)
+		}
+		if err := p.merge(ev, m.value, joinPath(path, m.key)); err != nil {
+			return err
+		}
+		rv.SetMapIndex(key, ev)
+	}
+	return nil
+}
+
+// clonePointer - 포인터 rv가 새로 할당한 값을 가리키게 합니다. nil이 아니면 원래 값을 복사해 둡니다.
+// clonePointer - Makes the pointer rv point to a newly allocated value, copying the original value if it is not nil.
+func clonePointer(rv reflect.Value) {
+	np := reflect.New(rv.Type().Elem())
+	if !rv.IsNil() {
+		np.Elem().Set(rv.Elem())
+	}
+	rv.Set(np)
+}
+
+// cloneMap - 맵의 얕은 복사본을 반환합니다. nil 맵이면 빈 맵을 반환합니다.
+// cloneMap - Returns a shallow copy of a map. Returns an empty map for a nil map.
+func cloneMap(m reflect.Value) reflect.Value {
+	c := reflect.MakeMapWithSize(m.Type(), m.Len())
+	for it := m.MapRange(); it.Next(); {
+		c.SetMapIndex(it.Key(), it.Value())
+	}
+	return c
+}
+
+// fieldByIndexClone - fieldByIndexAlloc과 같지만 경로상의 포인터를 clonePointer로 복제합니다.
+// fieldByIndexClone - Like fieldByIndexAlloc, but clones the pointers along the path with clonePointer.
+func fieldByIndexClone(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			clonePointer(v)
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// replace - 패치 값으로 rv를 통째로 교체합니다.
+// replace - Replaces rv as a whole with the patch value.
+func (p *mergePatcher) replace(rv reflect.Value, raw json.RawMessage, path string) error {
+	nv := reflect.New(rv.Type())
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if p.strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(nv.Interface()); err != nil {
+		return pathError(jsonError(err), path)
+	}
+	rv.Set(nv.Elem())
+	return nil
+}
+
+// jsonMember - JSON 객체의 멤버
+// jsonMember - A member of a JSON object.
+type jsonMember struct {
+	key   string
+	value json.RawMessage
+}
+
+// jsonMembers - JSON 객체의 멤버를 문서에 나온 순서대로 반환합니다.
+// jsonMembers - Returns the members of a JSON object in document order.
+func jsonMembers(raw json.RawMessage) ([]jsonMember, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	var members []jsonMember
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var m jsonMember
+		m.key, _ = tok.(string)
+		if err := dec.Decode(&m.value); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+// pathError - 에러의 필드 경로 앞에 path를 붙인 BindError를 반환합니다. path가 비어 있으면 err를 그대로 반환합니다.
+// pathError - Returns a BindError with path prepended to the error's field path. Returns err unchanged if path is empty.
+func pathError(err error, path string) error {
+	if path == "" {
+		return err
+	}
+	var bindErr BindError
+	if errors.As(err, &bindErr) {
+		if bindErr.Field != "" {
+			path = joinPath(path, bindErr.Field)
+		}
+		bindErr.Field = path
+		return bindErr
+	}
+	return BindError{Field: path, Err: err}
+}
+
+// jsonField - JSON 멤버 이름으로 찾을 수 있는 구조체 필드
+// jsonField - A struct field reachable by a JSON member name.
+type jsonField struct {
+	name  string
+	index []int
+}
+
+// jsonFields - 구조체 타입별 JSON 필드 목록
+// jsonFields - The JSON fields of a struct type.
+type jsonFields []jsonField
+
+// lookup - encoding/json과 같이 이름이 정확히 일치하는 필드를, 없으면 대소문자를 구분하지 않고 일치하는 필드를 찾습니다.
+// lookup - Like encoding/json, finds the field whose name matches exactly, or else case-insensitively.
+func (fs jsonFields) lookup(name string) *jsonField {
+	if f := fs.exact(name); f != nil {
+		return f
+	}
+	for i := range fs {
+		if strings.EqualFold(fs[i].name, name) {
+			return &fs[i]
+		}
+	}
+	return nil
+}
+
+// exact - 이름이 대소문자까지 정확히 일치하는 필드를 찾습니다.
+// exact - Finds the field whose name matches exactly, including case.
+func (fs jsonFields) exact(name string) *jsonField {
+	for i := range fs {
+		if fs[i].name == name {
+			return &fs[i]
+		}
+	}
+	return nil
+}
+
+// jsonFieldCache - 구조체 타입별 JSON 필드 목록 캐시
+// jsonFieldCache - A cache of JSON fields per struct type.
+var jsonFieldCache = &sync.Map{}
+
+// getJSONFields - 구조체 타입의 JSON 필드 목록을 반환합니다.
+// 이름은 json 태그, 없으면 필드 이름이며, `json:"-"`인 필드는 제외합니다. 태그 이름 없이 임베드된 구조체의 필드는 평탄화하며, 직접 선언된 필드가 우선합니다.
+// getJSONFields - Returns the JSON fields of a struct type.
+// The name is the json tag, or else the field name, and fields tagged `json:"-"` are skipped. Fields of structs embedded without a tag name are flattened; directly declared fields take precedence.
+func getJSONFields(rt reflect.Type) jsonFields {
+	if cached, ok := jsonFieldCache.Load(rt); ok {
+		return cached.(jsonFields)
+	}
+	fields := buildJSONFields(rt, []string{"json"}, map[reflect.Type]bool{})
+	jsonFieldCache.Store(rt, fields)
+	return fields
+}
+
+// buildJSONFields - 구조체 타입의 필드 목록을 만듭니다. 이름은 tags 중 처음으로 붙어 있는 태그에서 가져오며, seen은 임베드 순환을 막습니다.
+// buildJSONFields - Builds the fields of a struct type. Names come from the first of tags present on the field, and seen guards against embedding cycles.
+func buildJSONFields(rt reflect.Type, tags []string, seen map[reflect.Type]bool) jsonFields {
+	seen[rt] = true
+	defer delete(seen, rt)
+	var fields, embedded jsonFields
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		var name string
+		for _, tag := range tags {
+			if value, ok := sf.Tag.Lookup(tag); ok {
+				name, _, _ = strings.Cut(value, ",")
+				break
+			}
+		}
+		if name == "-" {
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			if (sf.Type.Kind() == reflect.Ptr && !sf.IsExported()) || seen[ft] {
+				continue
+			}
+			for _, f := range buildJSONFields(ft, tags, seen) {
+				embedded = append(embedded, jsonField{name: f.name, index: append([]int{i}, f.index...)})
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, jsonField{name: name, index: []int{i}})
+	}
+	for _, f := range embedded {
+		if !slices.ContainsFunc(fields, func(g jsonField) bool { return g.name == f.name }) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,74 @@
+package bind
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// decodeMsgPackRequest - MessagePack 본문을 디코딩합니다.
+// 구조체 필드는 msgpack 태그, 없으면 json 태그로 찾습니다. 본문 크기 제한, 중첩 깊이 제한, 엄격한 디코딩이 JSON과 같이 적용됩니다.
+// decodeMsgPackRequest - Decodes a MessagePack body.
+// Struct fields are matched by the msgpack tag, then the json tag. The body size limit, nesting depth limit and strict decoding apply as for JSON.
+func decodeMsgPackRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return bodyReadError(err)
+	}
+	if cfg.maxNesting > 0 {
+		if err := checkMsgPackDepth(msgpack.NewDecoder(bytes.NewReader(data)), 1, cfg.maxNesting); err != nil {
+			return err
+		}
+	}
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	dec.SetCustomStructTag("json")
+	dec.DisallowUnknownFields(cfg.strictDecoding)
+	if err := dec.Decode(v); err != nil {
+		if fieldErr := unknownFieldError(err); fieldErr != nil {
+			return fieldErr
+		}
+		return err
+	}
+	return nil
+}
+
+// checkMsgPackDepth - 다음 값의 배열과 맵 중첩 깊이가 maxDepth를 넘지 않는지 확인하며 값을 건너뜁니다.
+// 디코더가 재귀적으로 동작하므로 디코딩 전에 확인합니다.
+// checkMsgPackDepth - Skips over the next value, checking that its array and map nesting depth does not exceed maxDepth.
+// Checked before decoding, since the decoder is recursive.
+func checkMsgPackDepth(dec *msgpack.Decoder, depth, maxDepth int) error {
+	c, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	n := 0
+	switch {
+	case msgpcode.IsFixedMap(c) || c == msgpcode.Map16 || c == msgpcode.Map32:
+		if n, err = dec.DecodeMapLen(); err != nil {
+			return err
+		}
+		n *= 2
+	case msgpcode.IsFixedArray(c) || c == msgpcode.Array16 || c == msgpcode.Array32:
+		if n, err = dec.DecodeArrayLen(); err != nil {
+			return err
+		}
+	default:
+		return dec.Skip()
+	}
+	if depth > maxDepth {
+		return fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, maxDepth)
+	}
+	for i := 0; i < n; i++ {
+		if err := checkMsgPackDepth(dec, depth+1, maxDepth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package bind
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Part - multipart/mixed 또는 multipart/related 본문의 파트
+// `part` 태그가 붙은 *Part 또는 []*Part 필드에 바인딩됩니다.
+// Part - A part of a multipart/mixed or multipart/related body.
+// Bound into *Part or []*Part fields with a `part` tag.
+type Part struct {
+	// ContentID - 꺾쇠괄호를 제거한 Content-ID 헤더 값
+	// ContentID - The Content-ID header value with the angle brackets removed.
+	ContentID string
+	// Header - 파트의 MIME 헤더
+	// Header - The MIME header of the part.
+	Header textproto.MIMEHeader
+	// Body - 파트의 내용 (Content-Transfer-Encoding이 quoted-printable이면 디코딩된 내용)
+	// Body - The content of the part (decoded if the Content-Transfer-Encoding is quoted-printable).
+	Body []byte
+}
+
+// ContentType - 파트에 선언된 Content-Type을 반환합니다.
+// ContentType - Returns the Content-Type declared on the part.
+func (p *Part) ContentType() string {
+	return p.Header.Get("Content-Type")
+}
+
+var (
+	partPtrType   = reflect.TypeOf((*Part)(nil))
+	partSliceType = reflect.TypeOf(([]*Part)(nil))
+	stringType    = reflect.TypeOf("")
+)
+
+// partField - `part` 태그가 붙은 필드에 대한 캐시된 정보
+// 태그 값은 Content-ID, "#2" 같은 본문 안의 위치(0부터 시작), 또는 나머지 모든 파트를 뜻하는 "*"입니다.
+// partField - Cached information about a field with a `part` tag.
+// The tag value is a Content-ID, a position in the body such as "#2" (starting at 0), or "*" for all remaining parts.
+type partField struct {
+	index []int
+	id    string
+	pos   int
+	rest  bool
+}
+
+// partPlanCache - 구조체 타입별 `part` 태그 필드 목록 캐시
+// partPlanCache - A cache of `part` tagged fields per struct type.
+var partPlanCache = &sync.Map{}
+
+// partPlan - 구조체 타입별 파트 바인딩 계획
+// partPlan - The part binding plan for a struct type.
+type partPlan struct {
+	fields []partField
+	err    error
+}
+
+// getPartPlan - 구조체 타입의 파트 바인딩 계획을 반환합니다. 태그 오류도 함께 캐싱됩니다.
+// getPartPlan - Returns the part binding plan of a struct type. Tag errors are cached as well.
+func getPartPlan(rt reflect.Type) *partPlan {
+	if cached, ok := partPlanCache.Load(rt); ok {
+		return cached.(*partPlan)
+	}
+	plan := &partPlan{}
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag, ok := sf.Tag.Lookup("part")
+		if !ok || !sf.IsExported() {
+			continue
+		}
+		switch sf.Type {
+		case partPtrType, partSliceType, bytesType, stringType:
+		default:
+			plan.err = fmt.Errorf("bind: field %s: unsupported part field type %s", sf.Name, sf.Type)
+		}
+		f := partField{index: []int{i}, pos: -1}
+		switch {
+		case tag == "*":
+			f.rest = true
+			if sf.Type != partSliceType {
+				plan.err = fmt.Errorf("bind: field %s: part:\"*\" requires []*bind.Part", sf.Name)
+			}
+		case strings.HasPrefix(tag, "#"):
+			n, err := strconv.Atoi(tag[1:])
+			if err != nil || n < 0 {
+				plan.err = fmt.Errorf("bind: field %s: invalid part position %q", sf.Name, tag)
+			}
+			f.pos = n
+		default:
+			f.id = normalizeContentID(tag)
+			if f.id == "" {
+				plan.err = fmt.Errorf("bind: field %s: empty part tag", sf.Name)
+			}
+		}
+		if plan.err != nil {
+			break
+		}
+		plan.fields = append(plan.fields, f)
+	}
+	partPlanCache.Store(rt, plan)
+	return plan
+}
+
+// match - 파트에 해당하는 필드를 찾습니다. Content-ID, 위치, "*" 순으로 찾습니다.
+// match - Finds the field for a part. Looks by Content-ID, then position, then "*".
+func (p *partPlan) match(part *Part, pos int) *partField {
+	var byPos, rest *partField
+	for i := range p.fields {
+		f := &p.fields[i]
+		switch {
+		case f.id != "" && f.id == part.ContentID:
+			return f
+		case f.pos == pos && byPos == nil:
+			byPos = f
+		case f.rest && rest == nil:
+			rest = f
+		}
+	}
+	if byPos != nil {
+		return byPos
+	}
+	return rest
+}
+
+// normalizeContentID - Content-ID 값이나 "cid:" 참조에서 공백, 꺾쇠괄호, "cid:" 접두사를 제거합니다.
+// normalizeContentID - Strips spaces, angle brackets and the "cid:" prefix from a Content-ID value or "cid:" reference.
+func normalizeContentID(id string) string {
+	id = strings.TrimSpace(id)
+	id = strings.TrimPrefix(id, "cid:")
+	return strings.TrimSuffix(strings.TrimPrefix(id, "<"), ">")
+}
+
+// decodeMultipartPartsRequest - multipart/mixed 또는 multipart/related 본문을 디코딩합니다.
+// 루트 파트(multipart/related의 start 파라미터가 가리키는 파트, 없으면 첫 번째 파트)가 JSON이면 구조체에 디코딩하고,
+// 나머지 파트는 Content-ID 또는 위치로 `part` 태그가 붙은 필드에 바인딩합니다. 파트는 모두 메모리에 읽으며,
+// 합계는 멀티파트 메모리 제한을 넘을 수 없습니다.
+// decodeMultipartPartsRequest - Decodes a multipart/mixed or multipart/related body.
+// If the root part (the one named by multipart/related's start parameter, or the first part) is JSON, it is decoded into the struct,
+// and the remaining parts are bound by Content-ID or position to fields with a `part` tag. All parts are read into memory,
+// and their total cannot exceed the multipart memory limit.
+func decodeMultipartPartsRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	if limit := cfg.uploadLimits.MaxTotalSize; limit > 0 {
+		r.Body = http.MaxBytesReader(nil, r.Body, limit)
+	}
+	defer io.Copy(io.Discard, r.Body)
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return err
+	}
+	if params["boundary"] == "" {
+		return http.ErrMissingBoundary
+	}
+	parts, err := readParts(multipart.NewReader(r.Body, params["boundary"]), cfg.maxMultipartMemory)
+	if err != nil {
+		return err
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+
+	root := 0
+	if start := normalizeContentID(params["start"]); start != "" {
+		root = -1
+		for i, p := range parts {
+			if p.ContentID == start {
+				root = i
+				break
+			}
+		}
+		if root < 0 {
+			return fmt.Errorf("bind: start part %q not found", start)
+		}
+	}
+	rootType := parts[root].ContentType()
+	if rootType == "" {
+		rootType = params["type"]
+	}
+	if GetContentType(rootType) == ContentTypeJSON {
+		if err := decodeJSON(bytes.NewReader(parts[root].Body), v, cfg); err != nil {
+			return err
+		}
+	} else {
+		root = -1 // JSON이 아닌 루트는 다른 파트처럼 필드에 바인딩합니다.
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("bind: non-pointer passed to multipart parts decoder")
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	plan := getPartPlan(rv.Type())
+	if plan.err != nil {
+		return plan.err
+	}
+	for i, p := range parts {
+		if i == root {
+			continue
+		}
+		f := plan.match(p, i)
+		if f == nil {
+			continue
+		}
+		field := rv.FieldByIndex(f.index)
+		switch field.Type() {
+		case partPtrType:
+			field.Set(reflect.ValueOf(p))
+		case partSliceType:
+			field.Set(reflect.Append(field, reflect.ValueOf(p)))
+		case bytesType:
+			field.SetBytes(p.Body)
+		case stringType:
+			field.SetString(string(p.Body))
+		}
+	}
+	return nil
+}
+
+// readParts - 본문의 모든 파트를 메모리 제한 안에서 읽습니다.
+// readParts - Reads all parts of the body within the memory limit.
+func readParts(mr *multipart.Reader, limit int64) ([]*Part, error) {
+	var parts []*Part
+	remaining := limit
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, uploadReadError(err)
+		}
+		body, err := io.ReadAll(io.LimitReader(p, remaining+1))
+		if err != nil {
+			return nil, uploadReadError(err)
+		}
+		remaining -= int64(len(body))
+		if remaining < 0 {
+			return nil, fmt.Errorf("%w: parts exceed %d bytes", ErrUploadTooLarge, limit)
+		}
+		parts = append(parts, &Part{
+			ContentID: normalizeContentID(p.Header.Get("Content-ID")),
+			Header:    p.Header,
+			Body:      body,
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package bind
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+
+	"github.com/go-playground/form/v4"
+)
+
+// PartHandler - 스트리밍 멀티파트 모드에서 파일 파트를 받아 처리하는 함수
+// 파트는 도착하는 즉시 요청 순서대로 전달되며, 디스크나 메모리에 미리 버퍼링되지 않습니다.
+// 필드의 크기 제한, 내용 기반 타입 검사, 체크섬은 핸들러가 파트를 읽는 동안 적용되며, 위반하면 읽기가 그 에러를 반환하고 바인딩도 그 에러로 실패합니다.
+// PartHandler - A function that receives and handles a file part in streaming multipart mode.
+// Parts are delivered in request order as they arrive, without being buffered to disk or memory first.
+// The field's size limits, sniffed type check and checksum are applied while the handler reads the part; a violation is returned from the read and binding fails with it.
+type PartHandler func(part *multipart.Part) error
+
+var (
+	partHandlerType = reflect.TypeOf(PartHandler(nil))
+	writerType      = reflect.TypeOf((*io.Writer)(nil)).Elem()
+)
+
+// WithStreamingMultipart - 스트리밍 멀티파트 모드를 켜거나 끕니다.
+// 켜면 파트를 메모리나 디스크에 보관하지 않고 r.MultipartReader로 하나씩 읽습니다. 일반 값 파트는 구조체에 바인딩되고,
+// 파일 파트는 같은 이름의 PartHandler 또는 io.Writer 필드로 전달됩니다. *multipart.FileHeader 필드는 채워지지 않습니다.
+// 파일 파트 뒤에 다시 온 값은 슬라이스 필드에 덧붙고, 스칼라 필드는 나중 값으로 덮어씁니다.
+// WithStreamingMultipart - Turns streaming multipart mode on or off.
+// When on, parts are read one at a time with r.MultipartReader without being kept in memory or on disk. Value parts are bound
+// into the struct and file parts are handed to the PartHandler or io.Writer field of the same name. *multipart.FileHeader fields are not populated.
+// A value repeated after a file part is appended to a slice field and overwrites a scalar field.
+func WithStreamingMultipart(enabled bool) Option {
+	return func(c *config) { c.streamMultipart = enabled }
+}
+
+// decodeMultipartStream - 멀티파트 본문을 버퍼링 없이 순서대로 디코딩합니다.
+// 파일 파트를 핸들러에 넘기기 전에 그때까지 도착한 값 파트를 먼저 바인딩하므로, 핸들러는 앞서 전송된 필드를 볼 수 있습니다.
+// decodeMultipartStream - Decodes a multipart body in order without buffering.
+// Value parts received so far are bound before a file part is handed off, so handlers can see fields sent before them.
+func decodeMultipartStream(r *http.Request, v any, cfg *config) error {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("bind: non-pointer passed to multipart stream decoder")
+	}
+
+	isStruct := rv.Elem().Kind() == reflect.Struct
+	if isStruct {
+		if plan := getFilePlan(rv.Elem().Type()); plan.err != nil {
+			return plan.err
+		}
+	}
+
+	// 직전 flush 이후 새로 도착한 값(pending)만 대상에 디코딩하므로, 비용은 파일 수와 무관하게 값 파트 수에 비례합니다.
+	// form 디코더는 nil이 아닌 슬라이스에 덧붙이므로 파일 파트를 사이에 두고 반복된 키도 슬라이스에 누적됩니다.
+	// values는 checksumfield 조회를 위해 지금까지 받은 모든 값을 담습니다.
+	decoder := form.NewDecoder()
+	values, pending := url.Values{}, url.Values{}
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		batch := pending
+		pending = url.Values{}
+		return decoder.Decode(v, batch)
+	}
+
+	limits := cfg.uploadLimits
+	fileCount, fieldCount := 0, 0
+	var sent []string
+	perField := map[string]int{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return uploadReadError(err)
+		}
+		name := part.FormName()
+		if name == "" {
+			continue
+		}
+		sent = append(sent, name)
+
+		if part.FileName() == "" {
+			fieldCount++
+			if limits.MaxFields > 0 && fieldCount > limits.MaxFields {
+				return BindError{Field: name, Err: fmt.Errorf("%w: limit is %d", ErrTooManyFields, limits.MaxFields)}
+			}
+			b, err := io.ReadAll(io.LimitReader(part, cfg.maxMultipartMemory+1))
+			if err != nil {
+				return uploadReadError(err)
+			}
+			if int64(len(b)) > cfg.maxMultipartMemory {
+				return BindError{Field: name, Err: errors.New("multipart: value too large")}
+			}
+			values.Add(name, string(b))
+			pending.Add(name, string(b))
+			continue
+		}
+
+		fileCount++
+		if limits.MaxFiles > 0 && fileCount > limits.MaxFiles {
+			return BindError{Field: name, Err: fmt.Errorf("%w: limit is %d", ErrTooManyFiles, limits.MaxFiles)}
+		}
+		segs, ok := parseFileKey(name)
+		if !ok || !isStruct {
+			continue // 받을 필드가 없는 파일 파트는 NextPart가 읽고 버립니다.
+		}
+		ff, err := lookupFileField(rv.Elem().Type(), segs)
+		if err != nil {
+			return BindError{Field: name, Err: err}
+		}
+		if ff == nil {
+			continue
+		}
+		opts := ff.options(cfg)
+		perField[name]++
+		if opts.maxCount > 0 && perField[name] > opts.maxCount {
+			return BindError{Field: name, Err: fmt.Errorf("%w: limit is %d", ErrTooManyFiles, opts.maxCount)}
+		}
+		if opts.strictName {
+			if err := ValidateFilename(rawFilename(part.Header)); err != nil {
+				return BindError{Field: name, Err: err}
+			}
+		}
+		check, err := newChecksum(opts, part.FileName(), part.Header, values[siblingKey(name, opts.checksumField)], perField[name]-1)
+		if err != nil {
+			return BindError{Field: name, Err: err}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		field := fileFieldValue(rv.Elem(), segs)
+		if err := streamPart(r.Context(), cfg, field, part, opts, check); err != nil {
+			var bindErr BindError
+			if errors.As(err, &bindErr) {
+				return err
+			}
+			return BindError{Field: name, Err: err}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if fields := fieldsTarget(v); fields.IsValid() {
+		fields.Set(reflect.ValueOf(formFields(slices.Values(sent))))
+	}
+	return nil
+}
+
+// streamPart - 파일 파트를 필드의 타입에 맞게 PartHandler, io.Writer, FileStore 또는 []byte로 전달합니다.
+// 나중에 열어야 하는 타입(*File, io.ReadCloser 등)은 파트가 지나가면 다시 읽을 수 없으므로 스트리밍 모드에서 채워지지 않습니다.
+// streamPart - Hands a file part to a PartHandler, io.Writer, FileStore or []byte according to the field's type.
+// Types that are opened later (*File, io.ReadCloser and so on) are not populated in streaming mode, since a part cannot be re-read once passed.
+func streamPart(ctx context.Context, cfg *config, field reflect.Value, part *multipart.Part, opts fileOptions, check *checksum) error {
+	switch {
+	case field.Type() == storedFilePtrType:
+		sf, err := storePart(ctx, cfg, part, opts, check)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(sf))
+	case field.Type() == storedFileSliceType:
+		sf, err := storePart(ctx, cfg, part, opts, check)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.Append(field, reflect.ValueOf(sf)))
+	case field.Kind() == reflect.Func:
+		if field.IsNil() {
+			return nil
+		}
+		if err := checkDeclaredType(part.FileName(), part.Header, opts); err != nil {
+			return err
+		}
+		return handlePart(field.Convert(partHandlerType).Interface().(PartHandler), part, opts, cfg.uploadLimits, check)
+	case field.Type() == bytesType:
+		opts.maxSize = bytesLimit(cfg, opts)
+		buf := &bytes.Buffer{}
+		if _, err := copyPart(buf, part, opts, cfg.uploadLimits, check); err != nil {
+			return err
+		}
+		field.SetBytes(buf.Bytes())
+	case field.Type() == writerType:
+		if field.IsNil() {
+			return nil
+		}
+		_, err := copyPart(field.Interface().(io.Writer), part, opts, cfg.uploadLimits, check)
+		return err
+	}
+	return nil
+}
+
+// handlePart - 제한을 적용하는 copyPart를 거친 파트를 핸들러에 넘깁니다.
+// multipart.Part는 감쌀 수 없으므로 파트를 파이프로 다시 인코딩해 읽으며, 제한 위반은 핸들러의 읽기 에러로 전달됩니다.
+// 핸들러가 반환하면 복사를 멈추고, 복사 중에 난 에러가 있으면 핸들러의 에러보다 우선해 반환합니다.
+// handlePart - Hands the handler a part that passes through copyPart, which applies the limits.
+// A multipart.Part cannot be wrapped, so the part is re-encoded through a pipe and read back, and limit violations reach the handler as read errors.
+// Copying stops once the handler returns, and an error raised while copying is returned in preference to the handler's error.
+func handlePart(handler PartHandler, part *multipart.Part, opts fileOptions, limits UploadLimits, check *checksum) error {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	done := make(chan error, 1)
+	go func() {
+		w, err := mw.CreatePart(part.Header)
+		if err == nil {
+			_, err = copyPart(w, part, opts, limits, check)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+		done <- err
+	}()
+	guarded, err := multipart.NewReader(pr, mw.Boundary()).NextPart()
+	if err == nil {
+		err = handler(guarded)
+	}
+	// 핸들러가 파트를 끝까지 읽지 않았어도 복사 고루틴이 끝나도록 파이프를 닫습니다.
+	pr.CloseWithError(io.ErrClosedPipe)
+	if copyErr := <-done; copyErr != nil && !errors.Is(copyErr, io.ErrClosedPipe) {
+		return copyErr
+	}
+	return err
+}
+
+// copyPart - 허용 목록과 크기 제한을 적용하면서 파일 파트를 dst로 복사합니다.
+// 내용 기반 타입 검사를 위해 앞 512바이트를 먼저 읽어 확인한 뒤 나머지와 함께 씁니다.
+// check가 있으면 복사가 끝난 뒤 다이제스트를 비교하므로, 불일치는 dst에 모두 쓴 뒤에 보고됩니다.
+// copyPart - Copies a file part to dst while applying the allowlists and size limits.
+// The first 512 bytes are peeked for the sniffed type check and then written along with the rest.
+// With a check, the digest is compared once copying is done, so a mismatch is reported after everything was written to dst.
+func copyPart(dst io.Writer, part *multipart.Part, opts fileOptions, limits UploadLimits, check *checksum) (int64, error) {
+	if err := checkDeclaredType(part.FileName(), part.Header, opts); err != nil {
+		return 0, err
+	}
+	br := bufio.NewReaderSize(part, sniffLen)
+	if len(opts.types) > 0 {
+		head, err := br.Peek(sniffLen)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, uploadReadError(err)
+		}
+		if sniffed := http.DetectContentType(head); !matchMediaType(opts.types, sniffed) {
+			return 0, fmt.Errorf("%w: detected type %q of %q", ErrFileTypeNotAllowed, sniffed, part.FileName())
+		}
+	}
+
+	maxSize := opts.maxSize
+	if maxSize == 0 || (limits.MaxFileSize > 0 && limits.MaxFileSize < maxSize) {
+		maxSize = limits.MaxFileSize
+	}
+	if check != nil {
+		dst = io.MultiWriter(dst, check)
+	}
+	var src io.Reader = br
+	if maxSize > 0 {
+		src = io.LimitReader(br, maxSize+1)
+	}
+	n, err := io.Copy(dst, src)
+	if err != nil {
+		return n, uploadReadError(err)
+	}
+	if maxSize > 0 && n > maxSize {
+		return n, fmt.Errorf("%w: %q exceeds limit of %d bytes", ErrFileTooLarge, part.FileName(), maxSize)
+	}
+	if check != nil {
+		return n, check.verify()
+	}
+	return n, nil
+}
+
+// uploadReadError - 본문 전체 크기 제한 초과를 ErrUploadTooLarge로 변환합니다.
+// uploadReadError - Converts an exceeded body size limit into ErrUploadTooLarge.
+func uploadReadError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("%w: limit is %d bytes", ErrUploadTooLarge, maxBytesErr.Limit)
+	}
+	return err
+}
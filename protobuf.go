@@ -0,0 +1,82 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotProtoMessage - protobuf 본문을 proto.Message를 구현하지 않는 대상에 바인딩하려 할 때 반환되는 에러
+// ErrNotProtoMessage - Returned when a protobuf body is bound to a target that does not implement proto.Message.
+var ErrNotProtoMessage = errors.New("bind target does not implement proto.Message")
+
+// protoRecursionLimit - 설정된 중첩 깊이 제한을 protobuf 디코더의 메시지 재귀 제한으로 변환합니다.
+// protoRecursionLimit - Converts the configured nesting depth limit into the protobuf decoder's message recursion limit.
+func protoRecursionLimit(cfg *config) int {
+	if cfg.maxNesting <= 0 {
+		return math.MaxInt32
+	}
+	return cfg.maxNesting
+}
+
+// protoError - protobuf 디코더의 재귀 제한 초과 에러를 ErrMaxDepthExceeded로 변환합니다.
+// 디코더가 에러 타입을 제공하지 않아 메시지로 구분합니다.
+// protoError - Converts the protobuf decoder's recursion limit error into ErrMaxDepthExceeded.
+// The decoder does not expose an error type, so the message is used to tell it apart.
+func protoError(err error, cfg *config) error {
+	if strings.Contains(err.Error(), "recursion depth") {
+		return fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, cfg.maxNesting)
+	}
+	return err
+}
+
+// decodeProtobufRequest - 바이너리 protobuf 본문을 proto.Message 대상에 디코딩합니다.
+// 본문 크기 제한이 적용되고, 중첩 깊이 제한은 메시지 중첩 깊이에 적용됩니다. 알 수 없는 필드는 메시지에 보존됩니다.
+// decodeProtobufRequest - Decodes a binary protobuf body into a proto.Message target.
+// The body size limit applies, and the nesting depth limit applies to message nesting. Unknown fields are preserved in the message.
+func decodeProtobufRequest(r *http.Request, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNotProtoMessage, v)
+	}
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return bodyReadError(err)
+	}
+	opts := proto.UnmarshalOptions{RecursionLimit: protoRecursionLimit(cfg)}
+	if err := opts.Unmarshal(data, m); err != nil {
+		return protoError(err, cfg)
+	}
+	return nil
+}
+
+// decodeProtoJSON - JSON 문서를 protojson으로 proto.Message 대상에 디코딩합니다.
+// 필드 이름은 protobuf JSON 매핑(lowerCamelCase 또는 원래 필드 이름)을 따르며, 알 수 없는 필드는 엄격한 디코딩일 때만 거부됩니다.
+// decodeProtoJSON - Decodes a JSON document into a proto.Message target using protojson.
+// Field names follow the protobuf JSON mapping (lowerCamelCase or the original field name), and unknown fields are rejected only with strict decoding.
+func decodeProtoJSON(in io.Reader, m proto.Message, cfg *config) error {
+	data, err := io.ReadAll(newJSONDepthReader(in, cfg.maxNesting))
+	if err != nil {
+		return bodyReadError(err)
+	}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: !cfg.strictDecoding,
+		RecursionLimit: protoRecursionLimit(cfg),
+	}
+	if err := opts.Unmarshal(data, m); err != nil {
+		if fieldErr := unknownFieldError(err); fieldErr != nil {
+			return fieldErr
+		}
+		return protoError(err, cfg)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package bind
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// bodyFieldCache - 구조체 타입별 `bind:"body"` 필드 인덱스 캐시 (없으면 nil)
+// bodyFieldCache - A cache of the `bind:"body"` field index per struct type (nil if there is none).
+var bodyFieldCache = &sync.Map{}
+
+// bodyField - 구조체 타입에서 `bind:"body"` 태그가 붙은 필드의 인덱스를 반환합니다.
+// bodyField - Returns the index of the field tagged `bind:"body"` in a struct type.
+func bodyField(rt reflect.Type) []int {
+	if cached, ok := bodyFieldCache.Load(rt); ok {
+		return cached.([]int)
+	}
+	var index []int
+	for i := 0; i < rt.NumField(); i++ {
+		if sf := rt.Field(i); sf.IsExported() && sf.Tag.Get("bind") == "body" {
+			index = sf.Index
+			break
+		}
+	}
+	bodyFieldCache.Store(rt, index)
+	return index
+}
+
+// decodeRawRequest - text/plain, text/html, application/octet-stream 본문을 그대로 바인딩합니다.
+// 대상은 string, []byte를 기반 타입으로 하는 값이나 io.Reader, io.ReadCloser이거나, 그런 타입의 필드에 `bind:"body"` 태그가 붙은 구조체입니다.
+// string 대상에는 text/* 본문의 charset 파라미터에 따라 UTF-8로 변환한 내용이 담깁니다.
+// io.Reader 대상에는 본문을 읽지 않은 채 그대로 넘기므로, 크기 제한 초과는 핸들러가 읽을 때 *http.MaxBytesError로 나타납니다.
+// Content-Length가 이미 제한을 넘으면 읽기 전에 ErrBodyTooLarge를 반환합니다.
+// decodeRawRequest - Binds a text/plain, text/html or application/octet-stream body as is.
+// The target is a value whose underlying type is string or []byte, an io.Reader or io.ReadCloser, or a struct with a field of such a type tagged `bind:"body"`.
+// String targets receive the content converted to UTF-8 according to the charset parameter of text/* bodies.
+// io.Reader targets are handed the body unread, so an exceeded size limit shows up as an *http.MaxBytesError when the handler reads it.
+// If the Content-Length already exceeds the limit, ErrBodyTooLarge is returned before reading.
+func decodeRawRequest(r *http.Request, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("bind: raw body requires a non-nil pointer, got %T", v)
+	}
+	target := rv.Elem()
+	if target.Kind() == reflect.Struct {
+		index := bodyField(target.Type())
+		if index == nil {
+			return fmt.Errorf("bind: %s has no field tagged bind:\"body\"", target.Type())
+		}
+		target = target.FieldByIndex(index)
+	}
+
+	cfg := configFrom(r)
+	if cfg.maxBodySize > 0 && r.ContentLength > cfg.maxBodySize {
+		return fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, cfg.maxBodySize)
+	}
+	body := limitBody(r, cfg)
+	// io.Reader, io.ReadCloser처럼 본문이 구현하는 인터페이스에는 본문을 그대로 넘깁니다. 빈 인터페이스는 제외합니다.
+	if target.Kind() == reflect.Interface && target.NumMethod() > 0 && reflect.TypeOf(r.Body).Implements(target.Type()) {
+		target.Set(reflect.ValueOf(r.Body))
+		return nil
+	}
+	defer io.Copy(io.Discard, r.Body)
+
+	switch {
+	case target.Kind() == reflect.String:
+		reader, err := charsetReader(body, r.Header.Get("Content-Type"))
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return bodyReadError(err)
+		}
+		target.SetString(string(data))
+	case target.Kind() == reflect.Slice && target.Type().Elem().Kind() == reflect.Uint8:
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return bodyReadError(err)
+		}
+		target.SetBytes(data)
+	default:
+		return fmt.Errorf("bind: cannot bind raw body into %s", target.Type())
+	}
+	return nil
+}
+
+// charsetReader - text/* 본문을 charset 파라미터에 따라 UTF-8로 변환하는 reader를 반환합니다.
+// charset이 없거나 UTF-8이면, 또는 text/*가 아니면 본문을 그대로 반환합니다.
+// charsetReader - Returns a reader converting a text/* body to UTF-8 according to its charset parameter.
+// Returns the body unchanged if there is no charset, the charset is UTF-8, or the body is not text/*.
+func charsetReader(body io.Reader, contentType string) (io.Reader, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "text/") {
+		return body, nil
+	}
+	charset := params["charset"]
+	if charset == "" || strings.EqualFold(charset, "utf-8") || strings.EqualFold(charset, "us-ascii") {
+		return body, nil
+	}
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("bind: unsupported charset %q", charset)
+	}
+	return enc.NewDecoder().Reader(body), nil
+}
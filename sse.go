@@ -0,0 +1,255 @@
+package bind
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event - text/event-stream 본문의 이벤트
+// Data에는 이벤트의 data 필드가 디코딩됩니다. string과 []byte에는 그대로 담기고, 그 외 타입은 JSON 디코더로 디코딩된 뒤 Binder 재귀가 실행됩니다.
+// Event - An event of a text/event-stream body.
+// The event's data field is decoded into Data. It is stored as is in strings and []byte; other types are decoded with the JSON decoder and then run the Binder recursion.
+type Event[T any] struct {
+	// ID - 마지막 이벤트 ID. SSE 규칙대로 id 필드가 없는 이벤트는 이전 ID를 이어받습니다.
+	// ID - The last event ID. As SSE specifies, an event without an id field inherits the previous ID.
+	ID string
+	// Event - 이벤트 타입. event 필드가 없으면 "message"입니다.
+	// Event - The event type. "message" if there is no event field.
+	Event string
+	// Retry - 이벤트에 retry 필드가 있으면 그 재연결 시간, 없으면 0
+	// Retry - The reconnection time from the event's retry field, or 0 if there is none.
+	Retry time.Duration
+	// Data - 이벤트의 data 필드를 디코딩한 값
+	// Data - The value decoded from the event's data field.
+	Data T
+}
+
+// eventRecord - Event[T]가 구현하는 메타데이터 설정 인터페이스
+// Event가 아닌 레코드 타입에는 data 필드만 디코딩됩니다.
+// eventRecord - The metadata setting interface implemented by Event[T].
+// Record types other than Event only receive the decoded data field.
+type eventRecord interface {
+	setEvent(ev *sseEvent)
+	payload() any
+}
+
+func (e *Event[T]) setEvent(ev *sseEvent) {
+	e.ID, e.Event, e.Retry = ev.id, ev.event, ev.retry
+}
+
+func (e *Event[T]) payload() any { return &e.Data }
+
+// sseEvent - 파싱된 SSE 이벤트
+// sseEvent - A parsed SSE event.
+type sseEvent struct {
+	id    string
+	event string
+	retry time.Duration
+	data  []byte
+	line  int // 이벤트가 시작된 줄
+}
+
+// sseReader - text/event-stream 본문을 이벤트 단위로 읽습니다.
+// 줄 끝은 CRLF, LF, CR을 모두 받으며, 주석(":"로 시작하는 줄)과 알 수 없는 필드는 무시합니다.
+// sseReader - Reads a text/event-stream body event by event.
+// Line endings may be CRLF, LF or CR, and comments (lines starting with ":") and unknown fields are ignored.
+type sseReader struct {
+	br      *bufio.Reader
+	limit   int64    // 한 줄과 이벤트 data의 최대 바이트 수 (0 이하이면 제한 없음)
+	pending []string // CR로 나뉜 나머지 줄
+	line    int
+	lastID  string
+	eof     bool
+}
+
+// newSSEReader - 설정의 줄 크기 제한을 적용하는 sseReader를 만듭니다.
+// newSSEReader - Creates an sseReader applying the line size limit from the settings.
+func newSSEReader(body io.Reader, cfg *config) *sseReader {
+	return &sseReader{br: bufio.NewReader(body), limit: cfg.maxStreamLineSize}
+}
+
+// readLine - 다음 줄을 줄 끝 문자 없이 반환합니다. LF로 끝나는 줄이 제한을 넘으면 ErrLineTooLong을 반환합니다.
+// readLine - Returns the next line without its line ending. Returns ErrLineTooLong if a line ended by LF exceeds the limit.
+func (s *sseReader) readLine() (string, error) {
+	if len(s.pending) == 0 {
+		if s.eof {
+			return "", io.EOF
+		}
+		data, tooLong, err := readLine(s.br, s.limit)
+		if tooLong {
+			s.line++
+			return "", fmt.Errorf("%w: limit is %d bytes", ErrLineTooLong, s.limit)
+		}
+		if err == io.EOF {
+			s.eof = true
+			if len(data) == 0 {
+				return "", io.EOF
+			}
+		} else if err != nil {
+			return "", err
+		}
+		text := strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+		s.pending = strings.Split(text, "\r")
+	}
+	line := s.pending[0]
+	s.pending = s.pending[1:]
+	if s.line++; s.line == 1 {
+		line = strings.TrimPrefix(line, "\ufeff")
+	}
+	return line, nil
+}
+
+// next - 다음 이벤트를 반환합니다. 이벤트가 더 없으면 io.EOF를 반환합니다.
+// SSE 규칙대로 데이터가 없는 이벤트와, 빈 줄로 끝나기 전에 본문이 끝난 마지막 이벤트는 전달하지 않습니다.
+// next - Returns the next event. Returns io.EOF when there are no more events.
+// As SSE specifies, events without data and a final event cut off by the end of the body before its terminating blank line are not dispatched.
+func (s *sseReader) next() (*sseEvent, error) {
+	ev := &sseEvent{}
+	var data bytes.Buffer
+	hasData := false
+	for {
+		line, err := s.readLine()
+		if err != nil && err != io.EOF {
+			return nil, bodyReadError(err)
+		}
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if line == "" {
+			if hasData {
+				ev.id = s.lastID
+				if ev.event == "" {
+					ev.event = "message"
+				}
+				ev.data = bytes.TrimSuffix(data.Bytes(), []byte("\n"))
+				return ev, nil
+			}
+			ev = &sseEvent{}
+			continue
+		}
+		if ev.line == 0 {
+			ev.line = s.line
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			ev.event = value
+		case "data":
+			if s.limit > 0 && int64(data.Len()+len(value)) > s.limit {
+				return nil, fmt.Errorf("%w: event data exceeds %d bytes", ErrLineTooLong, s.limit)
+			}
+			data.WriteString(value)
+			data.WriteByte('\n')
+			hasData = true
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				s.lastID = value
+			}
+		case "retry":
+			if ms, err := strconv.ParseUint(value, 10, 63); err == nil {
+				ev.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// decodeEvent - 이벤트를 레코드 포인터 rec에 디코딩하고 Binder 재귀를 실행합니다.
+// rec가 Event[T]이면 메타데이터를 설정하고 Data에, 아니면 rec 자체에 data 필드를 디코딩합니다. 레코드가 nil 포인터이면 할당합니다.
+// decodeEvent - Decodes an event into the record pointer rec and runs the Binder recursion.
+// If rec is an Event[T], the metadata is set and the data field is decoded into Data; otherwise it is decoded into rec itself. A nil record pointer is allocated.
+func decodeEvent(r *http.Request, cfg *config, ev *sseEvent, rec any) error {
+	if pv := reflect.ValueOf(rec).Elem(); pv.Kind() == reflect.Ptr {
+		if pv.IsNil() {
+			pv.Set(reflect.New(pv.Type().Elem()))
+		}
+		rec = pv.Interface()
+	}
+	target := rec
+	if e, ok := rec.(eventRecord); ok {
+		e.setEvent(ev)
+		target = e.payload()
+	}
+	rv := reflect.ValueOf(target).Elem()
+	switch {
+	case rv.Kind() == reflect.String:
+		rv.SetString(string(ev.data))
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		rv.SetBytes(ev.data)
+	default:
+		if err := decodeJSON(bytes.NewReader(ev.data), target, cfg); err != nil {
+			return err
+		}
+	}
+	return bindRecord(r, cfg, target)
+}
+
+// streamEvents - text/event-stream 본문을 이벤트 단위로 디코딩하고 바인딩합니다.
+// streamEvents - Decodes and binds a text/event-stream body event by event.
+func streamEvents[T any](r *http.Request, cfg *config, yield func(T, error) bool) {
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	s := newSSEReader(body, cfg)
+	for records := 1; ; records++ {
+		var zero T
+		ev, err := s.next()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			yield(zero, lineError(err, s.line))
+			return
+		}
+		if cfg.maxStreamRecords > 0 && records > cfg.maxStreamRecords {
+			yield(zero, lineError(tooManyRecords(cfg), ev.line))
+			return
+		}
+		var rec T
+		err = decodeEvent(r, cfg, ev, &rec)
+		if !yield(rec, lineError(err, ev.line)) {
+			return
+		}
+	}
+}
+
+// decodeEventStreamRequest - text/event-stream 본문의 이벤트를 슬라이스에 디코딩합니다.
+// 요소 타입은 Event[T]이거나 data 필드를 담을 타입입니다. 에러는 "[2].name"처럼 이벤트 인덱스로 시작하는 경로와
+// 이벤트가 시작된 줄 번호를 담은 BindError로 보고됩니다.
+// decodeEventStreamRequest - Decodes the events of a text/event-stream body into a slice.
+// The element type is Event[T] or a type that holds the data field. Errors are reported as BindErrors carrying a path starting with
+// the event index, as in "[2].name", and the line number where the event started.
+func decodeEventStreamRequest(r *http.Request, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("bind: event stream body requires a pointer to a slice, got %T", v)
+	}
+	slice := rv.Elem()
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	s := newSSEReader(body, cfg)
+	for i := 0; ; i++ {
+		ev, err := s.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return lineError(err, s.line)
+		}
+		rec := reflect.New(slice.Type().Elem())
+		if err := decodeEvent(r, cfg, ev, rec.Interface()); err != nil {
+			return lineError(indexError(err, i), ev.line)
+		}
+		slice.Set(reflect.Append(slice, rec.Elem()))
+	}
+}
@@ -0,0 +1,313 @@
+package bind
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+// ErrNoFileStore - StoredFile 필드에 바인딩할 파일이 있지만 FileStore가 설정되지 않았을 때 반환되는 에러
+// ErrNoFileStore - Returned when a file targets a StoredFile field but no FileStore is configured.
+var ErrNoFileStore = errors.New("no file store configured")
+
+// FileStore - 업로드된 파일을 저장하는 백엔드 인터페이스
+// 바인딩 중에 업로드 내용을 스트리밍으로 저장하고, 이후 바인딩이 실패하면 Remove로 정리합니다.
+// FileStore - A backend interface for storing uploaded files.
+// Upload content is streamed into the store during binding, and cleaned up with Remove if binding later fails.
+type FileStore interface {
+	// Save - r의 내용을 저장하고 저장된 위치를 반환합니다. 읽기 도중 에러가 나면 부분적으로 저장된 내용을 남기지 않아야 합니다.
+	// Save - Stores the content of r and returns its location. Must not leave partial content behind if reading fails.
+	Save(ctx context.Context, filename string, r io.Reader) (path string, err error)
+	// Remove - Save가 반환한 위치의 파일을 삭제합니다.
+	// Remove - Deletes the file at a location returned by Save.
+	Remove(ctx context.Context, path string) error
+}
+
+// StoredFile - FileStore에 저장된 업로드 파일
+// StoredFile - An uploaded file saved in a FileStore.
+type StoredFile struct {
+	// Filename - 클라이언트가 보낸 원본 파일 이름
+	// Filename - The original filename sent by the client.
+	Filename string
+	// Path - FileStore가 반환한 저장 위치
+	// Path - The location returned by the FileStore.
+	Path string
+	// Size - 저장된 바이트 수
+	// Size - The number of bytes stored.
+	Size int64
+	// ContentType - 내용으로부터 추정한 MIME 타입
+	// ContentType - The MIME type detected from the content.
+	ContentType string
+	// SHA256 - 내용의 SHA-256 해시 (16진수)
+	// SHA256 - The SHA-256 hash of the content (hex).
+	SHA256 string
+	// Checksum - `file:"checksum=..."` 옵션으로 검증한 다이제스트 (16진수, 옵션이 없으면 빈 문자열)
+	// Checksum - The digest verified by the `file:"checksum=..."` option (hex, empty without the option).
+	Checksum string
+}
+
+// SafeFilename - SanitizeFilename으로 정리한 원본 파일 이름을 반환합니다.
+// SafeFilename - Returns the original filename cleaned up by SanitizeFilename.
+func (f *StoredFile) SafeFilename() string {
+	return SanitizeFilename(f.Filename)
+}
+
+var (
+	storedFilePtrType   = reflect.TypeOf((*StoredFile)(nil))
+	storedFileSliceType = reflect.TypeOf(([]*StoredFile)(nil))
+)
+
+// WithFileStore - *StoredFile, []*StoredFile 필드에 바인딩할 업로드를 저장할 FileStore를 지정합니다.
+// WithFileStore - Sets the FileStore that uploads bound to *StoredFile and []*StoredFile fields are saved into.
+func WithFileStore(store FileStore) Option {
+	return func(c *config) { c.fileStore = store }
+}
+
+// storeFile - src를 store에 저장하면서 크기, SHA-256, MIME 타입을 계산합니다.
+// 저장에 성공하면 바인딩 실패 시 삭제되도록 rollback에 등록합니다.
+// storeFile - Saves src into the store while computing its size, SHA-256 and MIME type.
+// On success, the file is registered with rollback so that it is removed if binding fails.
+func storeFile(ctx context.Context, cfg *config, filename string, src io.Reader) (*StoredFile, error) {
+	if cfg.fileStore == nil {
+		return nil, ErrNoFileStore
+	}
+	h := sha256.New()
+	head := &headWriter{limit: sniffLen}
+	var size int64
+	tee := io.TeeReader(src, io.MultiWriter(h, head, writerFunc(func(p []byte) (int, error) {
+		size += int64(len(p))
+		return len(p), nil
+	})))
+	path, err := cfg.fileStore.Save(ctx, filename, tee)
+	if err != nil {
+		return nil, err
+	}
+	store := cfg.fileStore
+	cfg.rollback.add(func() error { return store.Remove(context.WithoutCancel(ctx), path) })
+	return &StoredFile{
+		Filename:    filename,
+		Path:        path,
+		Size:        size,
+		ContentType: http.DetectContentType(head.buf),
+		SHA256:      hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// storeFileHeaders - 파싱된 업로드 파일들을 store에 저장합니다.
+// storeFileHeaders - Saves parsed uploaded files into the store.
+func storeFileHeaders(ctx context.Context, cfg *config, files []*multipart.FileHeader) ([]*StoredFile, error) {
+	stored := make([]*StoredFile, 0, len(files))
+	for _, fh := range files {
+		f, err := fh.Open()
+		if err != nil {
+			return nil, err
+		}
+		sf, err := storeFile(ctx, cfg, fh.Filename, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		stored = append(stored, sf)
+	}
+	return stored, nil
+}
+
+// storePart - 스트리밍 모드에서 파일 파트를 제한을 적용하며 store에 저장합니다.
+// 복사 고루틴이 끝날 때까지 기다리며, Save가 파트를 끝까지 읽지 않고 반환해도 복사 중에 난 에러를 함께 반환합니다.
+// storePart - Saves a file part into the store in streaming mode while applying the limits.
+// It waits for the copy goroutine to finish, and returns any error raised while copying even if Save returned without reading the part to the end.
+func storePart(ctx context.Context, cfg *config, part *multipart.Part, opts fileOptions, check *checksum) (*StoredFile, error) {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		_, err := copyPart(pw, part, opts, cfg.uploadLimits, check)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+	sf, err := storeFile(ctx, cfg, part.FileName(), pr)
+	// Save가 도중에 반환해도 복사 고루틴이 끝나도록 파이프를 닫습니다.
+	pr.CloseWithError(io.ErrClosedPipe)
+	copyErr := <-done
+	if copyErr != nil && !errors.Is(err, copyErr) {
+		if copyErr != io.ErrClosedPipe {
+			err = errors.Join(err, copyErr)
+		} else if err == nil {
+			// Save가 파트를 끝까지 읽지 않았으므로 제한 검사를 마치지 못했습니다.
+			err = fmt.Errorf("bind: file store returned before reading %q to the end", part.FileName())
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if check != nil {
+		sf.Checksum = check.sum
+	}
+	return sf, nil
+}
+
+// headWriter - 처음 limit 바이트만 보관하는 io.Writer
+// headWriter - An io.Writer that keeps only the first limit bytes.
+type headWriter struct {
+	buf   []byte
+	limit int
+}
+
+func (w *headWriter) Write(p []byte) (int, error) {
+	if n := w.limit - len(w.buf); n > 0 {
+		w.buf = append(w.buf, p[:min(n, len(p))]...)
+	}
+	return len(p), nil
+}
+
+// writerFunc - 함수를 io.Writer로 사용하기 위한 어댑터
+// writerFunc - An adapter for using a function as an io.Writer.
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+// DirStore - 로컬 디렉터리에 업로드를 저장하는 FileStore
+// DirStore - A FileStore that saves uploads into a local directory.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore - dir에 파일을 저장하는 DirStore를 생성합니다. 디렉터리가 없으면 만듭니다.
+// NewDirStore - Creates a DirStore that saves files into dir. Creates the directory if it does not exist.
+func NewDirStore(dir string) (*DirStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &DirStore{dir: dir}, nil
+}
+
+// Save - 클라이언트 파일 이름은 경로에 사용하지 않고, 확장자만 유지한 임의의 이름으로 저장합니다.
+// Save - Does not use the client filename in the path; saves under a random name that keeps only the extension.
+func (s *DirStore) Save(ctx context.Context, filename string, r io.Reader) (string, error) {
+	f, err := os.CreateTemp(s.dir, "upload-*"+safeExt(filename))
+	if err != nil {
+		return "", err
+	}
+	path := f.Name()
+	if _, err := io.Copy(f, contextReader{ctx: ctx, r: r}); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+// Remove - 저장된 파일을 삭제합니다.
+// Remove - Deletes a stored file.
+func (s *DirStore) Remove(ctx context.Context, path string) error {
+	if filepath.Dir(path) != filepath.Clean(s.dir) {
+		return fmt.Errorf("bind: %q is outside of the store directory", path)
+	}
+	return os.Remove(path)
+}
+
+// MemoryStore - 메모리에 업로드를 저장하는 FileStore
+// 테스트나 작은 파일을 잠시 보관하는 용도에 적합합니다.
+// MemoryStore - A FileStore that keeps uploads in memory.
+// Suitable for tests or for briefly holding small files.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	files map[string][]byte
+}
+
+// NewMemoryStore - 빈 MemoryStore를 생성합니다.
+// NewMemoryStore - Creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{files: make(map[string][]byte)}
+}
+
+// Save - 내용을 메모리에 저장하고 임의의 키를 반환합니다.
+// Save - Keeps the content in memory and returns a random key.
+func (s *MemoryStore) Save(ctx context.Context, filename string, r io.Reader) (string, error) {
+	data, err := io.ReadAll(contextReader{ctx: ctx, r: r})
+	if err != nil {
+		return "", err
+	}
+	var id [16]byte
+	rand.Read(id[:])
+	path := hex.EncodeToString(id[:]) + safeExt(filename)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[path] = data
+	return path, nil
+}
+
+// Remove - 저장된 내용을 삭제합니다.
+// Remove - Deletes the stored content.
+func (s *MemoryStore) Remove(ctx context.Context, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.files[path]; !ok {
+		return os.ErrNotExist
+	}
+	delete(s.files, path)
+	return nil
+}
+
+// Open - 저장된 내용을 읽는 io.ReadCloser를 반환합니다.
+// Open - Returns an io.ReadCloser reading the stored content.
+func (s *MemoryStore) Open(path string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.files[path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Len - 저장된 파일 수를 반환합니다.
+// Len - Returns the number of stored files.
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.files)
+}
+
+// safeExt - 파일 이름에서 저장 경로에 써도 안전한 확장자만 추출합니다.
+// safeExt - Extracts only an extension from the filename that is safe to use in a storage path.
+func safeExt(filename string) string {
+	ext := filepath.Ext(filepath.Base(filename))
+	if len(ext) > 16 {
+		return ""
+	}
+	for _, c := range ext[min(1, len(ext)):] {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return ""
+		}
+	}
+	return ext
+}
+
+// contextReader - 컨텍스트가 취소되면 읽기를 중단하는 io.Reader
+// contextReader - An io.Reader that stops reading once the context is cancelled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
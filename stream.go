@@ -0,0 +1,299 @@
+package bind
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"reflect"
+	"strconv"
+)
+
+// ErrUnsupportedStream - Stream이 지원하지 않는 Content-Type의 요청을 받았을 때 반환되는 에러
+// ErrUnsupportedStream - Returned when Stream receives a request with a Content-Type it does not support.
+var ErrUnsupportedStream = errors.New("unsupported content type for streaming")
+
+// ErrTooManyRecords - Stream으로 읽은 레코드 수가 WithMaxStreamRecords로 지정한 수를 초과했을 때 반환되는 에러
+// ErrTooManyRecords - Returned when the number of records read with Stream exceeds the count set with WithMaxStreamRecords.
+var ErrTooManyRecords = errors.New("too many records")
+
+// WithMaxStreamRecords - Stream이 읽을 최대 레코드 수를 지정합니다. 0 이하의 값은 제한을 해제합니다.
+// 제한을 넘으면 ErrTooManyRecords를 전달하고 이터레이션을 끝냅니다.
+// WithMaxStreamRecords - Sets the maximum number of records Stream reads. A value of 0 or less disables the limit.
+// Exceeding it yields ErrTooManyRecords and ends the iteration.
+func WithMaxStreamRecords(n int) Option {
+	return func(c *config) { c.maxStreamRecords = n }
+}
+
+// ErrLineTooLong - NDJSON이나 text/event-stream 본문의 한 줄, 또는 SSE 이벤트 data 전체가 WithMaxStreamLineSize로 지정한 크기를 초과했을 때 반환되는 에러
+// ErrLineTooLong - Returned when a line of an NDJSON or text/event-stream body, or the whole data of an SSE event, exceeds the size set with WithMaxStreamLineSize.
+var ErrLineTooLong = errors.New("stream line too long")
+
+// DefaultMaxStreamLineSize - NDJSON과 text/event-stream 본문의 한 줄의 기본 최대 바이트 수
+// DefaultMaxStreamLineSize - The default maximum bytes of a line of an NDJSON or text/event-stream body.
+const DefaultMaxStreamLineSize int64 = 1 << 20
+
+// WithMaxStreamLineSize - NDJSON과 text/event-stream 본문의 한 줄의 최대 바이트 수(줄바꿈 제외)를 지정합니다. 0 이하의 값은 제한을 해제합니다.
+// NDJSON에서 제한을 넘는 줄은 보관하지 않고 건너뛰며, 그 줄 번호를 담은 ErrLineTooLong을 전달하고 이터레이션을 계속합니다.
+// SSE에서는 한 이벤트의 data 전체에도 같은 제한이 적용되며, 제한을 넘으면 줄 번호를 담은 ErrLineTooLong으로 디코딩이 끝납니다.
+// WithMaxStreamLineSize - Sets the maximum bytes of a line of an NDJSON or text/event-stream body, excluding the newline. A value of 0 or less disables the limit.
+// For NDJSON, a line over the limit is skipped without being kept, and ErrLineTooLong carrying its line number is yielded before iteration continues.
+// For SSE, the same limit also applies to the whole data of an event, and exceeding it ends decoding with ErrLineTooLong carrying the line number.
+func WithMaxStreamLineSize(size int64) Option {
+	return func(c *config) { c.maxStreamLineSize = size }
+}
+
+// Stream - 요청 본문의 레코드를 하나씩 T에 디코딩하고 바인딩하는 이터레이터를 반환합니다.
+// 본문 전체를 메모리에 올리지 않으므로 대량 수집 엔드포인트에 사용합니다. 각 레코드마다 Binder 재귀가 Action과 같이 실행됩니다.
+// 지원하는 형식:
+//   - NDJSON(application/x-ndjson 등): 한 줄에 JSON 값 하나. 빈 줄은 건너뜁니다. 에러는 줄 번호를 담으며, 한 줄의 크기는 WithMaxStreamLineSize로 제한됩니다.
+//   - JSON(application/json 등): 최상위 배열의 각 요소. 에러 경로는 "[3].id"처럼 요소 인덱스로 시작합니다.
+//   - CSV(text/csv 등): 헤더 다음의 각 행. T는 구조체나 구조체 포인터여야 하며, 에러는 줄 번호와 열 이름을 담습니다.
+//   - SSE(text/event-stream): 각 이벤트. T가 Event[P]이면 메타데이터와 함께 data 필드가 P에, 아니면 T에 디코딩됩니다. 에러는 이벤트가 시작된 줄 번호를 담습니다.
+//
+// 레코드의 디코딩이나 바인딩 에러는 BindError로 전달되며 이터레이션은 계속됩니다. 본문 읽기 에러나 문법 에러 이후에는 끝납니다.
+// opts는 이 호출에만 적용됩니다.
+// Stream - Returns an iterator that decodes and binds the records of the request body into T one at a time.
+// The whole body is never held in memory, which suits bulk ingest endpoints. The Binder recursion runs for each record as in Action.
+// Supported formats:
+//   - NDJSON (application/x-ndjson and the like): one JSON value per line. Blank lines are skipped. Errors carry the line number, and the size of a line is limited by WithMaxStreamLineSize.
+//   - JSON (application/json and the like): each element of a top-level array. Error paths start with the element index, as in "[3].id".
+//   - CSV (text/csv and the like): each row after the header. T must be a struct or struct pointer, and errors carry the line number and column name.
+//   - SSE (text/event-stream): each event. If T is Event[P], the data field is decoded into P along with the metadata, otherwise into T. Errors carry the line number where the event started.
+//
+// Decoding or binding errors of a record are yielded as BindErrors, and iteration continues. It ends after a body read error or a syntax error.
+// opts apply to this call only.
+func Stream[T any](r *http.Request, opts ...Option) iter.Seq2[T, error] {
+	return StreamWith[T](defaultEngine, r, opts...)
+}
+
+// StreamWith - 엔진 설정으로 Stream과 같은 이터레이터를 반환합니다. Go 메서드는 타입 매개변수를 가질 수 없어 함수로 제공합니다.
+// StreamWith - Returns the same iterator as Stream using the engine settings. It is a function because Go methods cannot have type parameters.
+func StreamWith[T any](e *Engine, r *http.Request, opts ...Option) iter.Seq2[T, error] {
+	ctxOpts, _ := r.Context().Value(optionsKey{}).([]Option)
+	cfg := newConfig(e.opts, ctxOpts, opts)
+	return func(yield func(T, error) bool) {
+		var zero T
+		switch GetContentType(r.Header.Get("Content-Type")) {
+		case ContentTypeNDJSON:
+			streamNDJSON(r, cfg, yield)
+		case ContentTypeJSON:
+			streamJSONArray(r, cfg, yield)
+		case ContentTypeCSV:
+			streamCSV(r, cfg, yield)
+		case ContentTypeEventStream:
+			streamEvents(r, cfg, yield)
+		default:
+			yield(zero, ErrUnsupportedStream)
+		}
+	}
+}
+
+// streamNDJSON - NDJSON 본문을 줄 단위로 디코딩하고 바인딩합니다.
+// streamNDJSON - Decodes and binds an NDJSON body line by line.
+func streamNDJSON[T any](r *http.Request, cfg *config, yield func(T, error) bool) {
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	br := bufio.NewReader(body)
+	records := 0
+	for line := 1; ; line++ {
+		data, tooLong, readErr := readLine(br, cfg.maxStreamLineSize)
+		if readErr != nil && readErr != io.EOF {
+			// 읽다 만 줄은 디코딩하지 않고 읽기 에러만 전달합니다.
+			var zero T
+			yield(zero, lineError(bodyReadError(readErr), line))
+			return
+		}
+		if tooLong {
+			var zero T
+			err := fmt.Errorf("%w: limit is %d bytes", ErrLineTooLong, cfg.maxStreamLineSize)
+			if !yield(zero, lineError(err, line)) {
+				return
+			}
+		} else if len(bytes.TrimSpace(data)) > 0 {
+			if records++; cfg.maxStreamRecords > 0 && records > cfg.maxStreamRecords {
+				var zero T
+				yield(zero, lineError(tooManyRecords(cfg), line))
+				return
+			}
+			rec, err := decodeRecord[T](r, cfg, data)
+			if !yield(rec, lineError(err, line)) {
+				return
+			}
+		}
+		if readErr == io.EOF {
+			return
+		}
+	}
+}
+
+// readLine - 줄바꿈까지 한 줄을 읽습니다. limit(0 이하이면 제한 없음)를 넘는 줄은 보관하지 않고 끝까지 건너뛰며 tooLong을 true로 반환합니다.
+// readLine - Reads a line up to the newline. A line over limit (no limit if 0 or less) is skipped to its end without being kept, and tooLong is returned as true.
+func readLine(br *bufio.Reader, limit int64) (line []byte, tooLong bool, err error) {
+	for {
+		chunk, err := br.ReadSlice('\n')
+		if !tooLong {
+			size := int64(len(line) + len(bytes.TrimSuffix(chunk, []byte("\n"))))
+			if limit > 0 && size > limit {
+				line, tooLong = nil, true
+			} else {
+				line = append(line, chunk...)
+			}
+		}
+		if err != bufio.ErrBufferFull {
+			return line, tooLong, err
+		}
+	}
+}
+
+// streamJSONArray - 최상위 JSON 배열을 토큰 단위로 읽으며 요소를 하나씩 디코딩하고 바인딩합니다.
+// streamJSONArray - Reads a top-level JSON array token by token, decoding and binding its elements one at a time.
+func streamJSONArray[T any](r *http.Request, cfg *config, yield func(T, error) bool) {
+	var zero T
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	dec := json.NewDecoder(newJSONDepthReader(body, cfg.maxNesting))
+	if cfg.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		yield(zero, BindError{Err: bodyReadError(err)})
+		return
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		yield(zero, BindError{Err: errors.New("bind: expected a JSON array")})
+		return
+	}
+	for i := 0; dec.More(); i++ {
+		if cfg.maxStreamRecords > 0 && i >= cfg.maxStreamRecords {
+			yield(zero, indexError(tooManyRecords(cfg), i))
+			return
+		}
+		var rec T
+		if err := dec.Decode(&rec); err != nil {
+			// 타입 에러와 알 수 없는 필드는 요소를 끝까지 읽은 뒤에 보고되므로 다음 요소로 넘어갈 수 있습니다.
+			var typeErr *json.UnmarshalTypeError
+			recoverable := errors.As(err, &typeErr) || unknownFieldError(err) != nil
+			if !yield(rec, indexError(jsonError(err), i)) || !recoverable {
+				return
+			}
+			continue
+		}
+		if !yield(rec, indexError(bindRecord(r, cfg, &rec), i)) {
+			return
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		yield(zero, BindError{Err: bodyReadError(err)})
+	}
+}
+
+// streamCSV - CSV 본문을 행 단위로 디코딩하고 바인딩합니다.
+// streamCSV - Decodes and binds a CSV body row by row.
+func streamCSV[T any](r *http.Request, cfg *config, yield func(T, error) bool) {
+	var zero T
+	rowType := reflect.TypeFor[T]()
+	if rowType.Kind() == reflect.Ptr {
+		rowType = rowType.Elem()
+	}
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	d, err := newCSVDecoder(body, rowType, cfg.strictDecoding)
+	if err != nil {
+		yield(zero, err)
+		return
+	}
+	for records := 1; ; records++ {
+		var rec T
+		row := reflect.ValueOf(&rec).Elem()
+		if row.Kind() == reflect.Ptr {
+			row.Set(reflect.New(rowType))
+			row = row.Elem()
+		}
+		line, err := d.read(row)
+		if err == io.EOF {
+			return
+		}
+		if cfg.maxStreamRecords > 0 && records > cfg.maxStreamRecords {
+			yield(zero, lineError(tooManyRecords(cfg), line))
+			return
+		}
+		if err == nil {
+			err = bindRecord(r, cfg, &rec)
+		}
+		if !yield(rec, lineError(err, line)) || (err != nil && !csvRecoverable(err)) {
+			return
+		}
+	}
+}
+
+// decodeRecord - JSON 레코드 하나를 T에 디코딩하고 Binder 재귀를 실행합니다.
+// decodeRecord - Decodes a single JSON record into T and runs the Binder recursion.
+func decodeRecord[T any](r *http.Request, cfg *config, data []byte) (T, error) {
+	var rec T
+	dec := json.NewDecoder(newJSONDepthReader(bytes.NewReader(data), cfg.maxNesting))
+	if cfg.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(&rec); err != nil {
+		return rec, jsonError(err)
+	}
+	if dec.More() {
+		return rec, errors.New("bind: unexpected data after JSON value")
+	}
+	return rec, bindRecord(r, cfg, &rec)
+}
+
+// bindRecord - 레코드 포인터 rec가 가리키는 값에 Binder 재귀를 실행합니다. 레코드가 포인터이면 그 포인터가 가리키는 값을 바인딩합니다.
+// bindRecord - Runs the Binder recursion on the value the record pointer rec points to. If the record is itself a pointer, the value it points to is bound.
+func bindRecord(r *http.Request, cfg *config, rec any) error {
+	rv := reflect.ValueOf(rec)
+	if rv.Elem().Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	b := &binding{r: r, maxDepth: cfg.maxDepth}
+	return b.bind(rv, "", 0)
+}
+
+// tooManyRecords - 레코드 수 제한 초과 에러를 반환합니다.
+// tooManyRecords - Returns the error for an exceeded record count limit.
+func tooManyRecords(cfg *config) error {
+	return fmt.Errorf("%w: limit is %d", ErrTooManyRecords, cfg.maxStreamRecords)
+}
+
+// indexError - 에러 경로 앞에 배열 요소 인덱스를 붙인 BindError를 반환합니다. err가 nil이면 nil을 반환합니다.
+// indexError - Returns a BindError with the array element index prepended to the error path. Returns nil if err is nil.
+func indexError(err error, i int) error {
+	if err == nil {
+		return nil
+	}
+	index := "[" + strconv.Itoa(i) + "]"
+	var bindErr BindError
+	if errors.As(err, &bindErr) {
+		if bindErr.Field != "" {
+			index = joinPath(index, bindErr.Field)
+		}
+		bindErr.Field = index
+		return bindErr
+	}
+	return BindError{Field: index, Err: err}
+}
+
+// lineError - 에러에 줄 번호를 붙인 BindError를 반환합니다. err가 nil이면 nil을 반환합니다.
+// lineError - Returns a BindError with the line number attached to err. Returns nil if err is nil.
+func lineError(err error, line int) error {
+	if err == nil {
+		return nil
+	}
+	var bindErr BindError
+	if errors.As(err, &bindErr) {
+		bindErr.Line = line
+		return bindErr
+	}
+	return BindError{Line: line, Err: err}
+}
@@ -0,0 +1,172 @@
+package bind
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+)
+
+// decodeTOMLRequest - TOML 본문을 toml 태그를 사용해 디코딩합니다.
+// 본문 크기 제한과 중첩 깊이 제한이 JSON과 같이 적용되며, 디코딩 에러는 문서 위치로부터 찾은 키 경로를 담은 BindError로 보고됩니다.
+// decodeTOMLRequest - Decodes a TOML body using toml tags.
+// The body size and nesting depth limits apply as for JSON, and decoding errors are reported as BindErrors carrying the key path found from the document position.
+func decodeTOMLRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return bodyReadError(err)
+	}
+	if cfg.maxNesting > 0 && tomlNestingDepth(data) > cfg.maxNesting {
+		return fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, cfg.maxNesting)
+	}
+	dec := toml.NewDecoder(bytes.NewReader(data))
+	if cfg.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		var strictErr *toml.StrictMissingError
+		if errors.As(err, &strictErr) && len(strictErr.Errors) > 0 {
+			key := strings.Join(strictErr.Errors[0].Key(), ".")
+			return BindError{Field: key, Err: fmt.Errorf("%w %q", ErrUnknownField, key)}
+		}
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			if path := tomlErrorPath(data, decodeErr); path != "" {
+				return BindError{Field: path, Err: err}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// tomlErrorPath - 디코딩 에러가 가리키는 위치의 값을 찾아 "servers[1].port" 형식의 키 경로를 반환합니다.
+// 위치에 해당하는 값을 찾지 못하면 빈 문자열을 반환합니다.
+// tomlErrorPath - Finds the value at the position of a decoding error and returns its key path in the form "servers[1].port".
+// Returns an empty string if no value is found at the position.
+func tomlErrorPath(data []byte, decodeErr *toml.DecodeError) string {
+	if key := decodeErr.Key(); len(key) > 0 {
+		return strings.Join(key, ".")
+	}
+	row, col := decodeErr.Position()
+	offset := 0
+	for i := 1; i < row; i++ {
+		n := bytes.IndexByte(data[offset:], '\n')
+		if n < 0 {
+			return ""
+		}
+		offset += n + 1
+	}
+	offset += col - 1
+
+	var p unstable.Parser
+	p.Reset(data)
+	table := ""
+	arrayTables := map[string]int{}
+	for p.NextExpression() {
+		e := p.Expression()
+		switch e.Kind {
+		case unstable.Table, unstable.ArrayTable:
+			// 중첩된 배열 테이블이 가장 최근 요소를 가리키도록 경로를 앞에서부터 만듭니다.
+			table = ""
+			for it := e.Key(); it.Next(); {
+				table = joinPath(table, string(it.Node().Data))
+				if n, ok := arrayTables[table]; ok && !it.IsLast() {
+					table += "[" + strconv.Itoa(n-1) + "]"
+				}
+			}
+			if e.Kind == unstable.ArrayTable {
+				arrayTables[table]++
+				table += "[" + strconv.Itoa(arrayTables[table]-1) + "]"
+			}
+		case unstable.KeyValue:
+			if path, ok := tomlValuePath(e, table, offset); ok {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// tomlValuePath - 키-값 노드 안에서 offset 위치의 값을 찾아 그 경로를 반환합니다.
+// tomlValuePath - Finds the value at offset within a key-value node and returns its path.
+func tomlValuePath(kv *unstable.Node, prefix string, offset int) (string, bool) {
+	path := prefix
+	for it := kv.Key(); it.Next(); {
+		path = joinPath(path, string(it.Node().Data))
+	}
+	return tomlFindValue(kv.Value(), path, offset)
+}
+
+// tomlFindValue - 값 노드와 그 하위 노드 중 offset을 포함하는 값을 찾습니다.
+// tomlFindValue - Finds the value containing offset among a value node and its children.
+func tomlFindValue(n *unstable.Node, path string, offset int) (string, bool) {
+	switch n.Kind {
+	case unstable.Array:
+		i := 0
+		for it := n.Children(); it.Next(); i++ {
+			if p, ok := tomlFindValue(it.Node(), path+"["+strconv.Itoa(i)+"]", offset); ok {
+				return p, true
+			}
+		}
+	case unstable.InlineTable:
+		for it := n.Children(); it.Next(); {
+			if p, ok := tomlValuePath(it.Node(), path, offset); ok {
+				return p, true
+			}
+		}
+	}
+	start := int(n.Raw.Offset)
+	if n.Raw.Length > 0 && offset >= start && offset < start+int(n.Raw.Length) {
+		return path, true
+	}
+	return "", false
+}
+
+// tomlNestingDepth - TOML 문서에서 배열과 인라인 테이블의 최대 중첩 깊이를 계산합니다.
+// 문자열과 주석 안의 괄호는 세지 않습니다. 파서가 재귀적으로 동작하므로 파싱 전에 확인합니다.
+// tomlNestingDepth - Computes the maximum nesting depth of arrays and inline tables in a TOML document.
+// Brackets inside strings and comments are not counted. Checked before parsing, since the parser is recursive.
+func tomlNestingDepth(data []byte) int {
+	depth, maxDepth := 0, 0
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; c {
+		case '#':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+		case '"', '\'':
+			delim := []byte{c}
+			if bytes.HasPrefix(data[i:], []byte{c, c, c}) {
+				delim = []byte{c, c, c}
+			}
+			i += len(delim)
+			for i < len(data) && !bytes.HasPrefix(data[i:], delim) {
+				if c == '"' && data[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			// 여러 줄 문자열은 닫는 따옴표 뒤에 따옴표가 최대 두 개 더 올 수 있습니다.
+			for len(delim) == 3 && i+3 < len(data) && data[i+3] == c {
+				i++
+			}
+			i += len(delim) - 1
+		case '[', '{':
+			depth++
+			maxDepth = max(maxDepth, depth)
+		case ']', '}':
+			depth--
+		}
+	}
+	return maxDepth
+}
@@ -0,0 +1,385 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrFileTooLarge - 업로드된 파일이 파일당 크기 제한을 초과했을 때 반환되는 에러
+	// ErrFileTooLarge - Returned when an uploaded file exceeds the per-file size limit.
+	ErrFileTooLarge = errors.New("file too large")
+	// ErrTooManyFiles - 업로드된 파일 수가 제한을 초과했을 때 반환되는 에러
+	// ErrTooManyFiles - Returned when the number of uploaded files exceeds the limit.
+	ErrTooManyFiles = errors.New("too many files")
+	// ErrTooManyFields - 파일이 아닌 폼 필드 값의 수가 제한을 초과했을 때 반환되는 에러
+	// ErrTooManyFields - Returned when the number of non-file form values exceeds the limit.
+	ErrTooManyFields = errors.New("too many fields")
+	// ErrUploadTooLarge - 멀티파트 본문 전체 크기가 제한을 초과했을 때 반환되는 에러
+	// ErrUploadTooLarge - Returned when the whole multipart body exceeds the total size limit.
+	ErrUploadTooLarge = errors.New("upload too large")
+	// ErrFileTypeNotAllowed - 업로드된 파일의 MIME 타입 또는 확장자가 허용 목록에 없을 때 반환되는 에러
+	// ErrFileTypeNotAllowed - Returned when an uploaded file's MIME type or extension is not in the allowlist.
+	ErrFileTypeNotAllowed = errors.New("file type not allowed")
+)
+
+var (
+	fileHeaderPtrType   = reflect.TypeOf((*multipart.FileHeader)(nil))
+	fileHeaderSliceType = reflect.TypeOf(([]*multipart.FileHeader)(nil))
+)
+
+// sniffLen - http.DetectContentType이 검사하는 최대 바이트 수
+// sniffLen - The maximum number of bytes inspected by http.DetectContentType.
+const sniffLen = 512
+
+// UploadLimits - 멀티파트 업로드 제한
+// 0인 항목은 제한하지 않습니다. 필드별 제한은 `file:"max=5MB,count=1"` 태그로 추가로 지정할 수 있습니다.
+// UploadLimits - Multipart upload limits.
+// Zero values mean no limit. Per-field limits can additionally be set with a `file:"max=5MB,count=1"` tag.
+type UploadLimits struct {
+	// MaxFiles - 요청 전체의 최대 파일 수
+	// MaxFiles - The maximum number of files in the whole request.
+	MaxFiles int
+	// MaxFields - 요청 전체의 파일이 아닌 최대 필드 값 수
+	// MaxFields - The maximum number of non-file field values in the whole request.
+	MaxFields int
+	// MaxFileSize - 파일 하나의 최대 바이트 수
+	// MaxFileSize - The maximum size of a single file in bytes.
+	MaxFileSize int64
+	// MaxTotalSize - 멀티파트 본문 전체의 최대 바이트 수 (읽는 도중에 적용됩니다)
+	// MaxTotalSize - The maximum size of the whole multipart body in bytes (enforced while reading).
+	MaxTotalSize int64
+}
+
+// fileOptions - `file` 태그로 지정된 필드별 옵션
+// fileOptions - Per-field options given by the `file` tag.
+type fileOptions struct {
+	maxSize    int64
+	maxCount   int
+	types      []string
+	exts       []string
+	strictName bool
+	// checksum, checksumField - 검증할 해시 알고리즘과 기대 다이제스트를 담은 형제 필드 이름
+	// checksum, checksumField - The hash algorithm to verify and the sibling field holding the expected digest.
+	checksum      string
+	checksumField string
+}
+
+// parseFileTag - `file:"max=5MB,count=1,types=image/png|image/jpeg,ext=.png|.jpg"` 형식의 태그를 파싱합니다.
+// strictname, checksum=sha256, checksumfield=name 옵션도 지원합니다.
+// parseFileTag - Parses a tag of the form `file:"max=5MB,count=1,types=image/png|image/jpeg,ext=.png|.jpg"`.
+// The strictname, checksum=sha256 and checksumfield=name options are supported as well.
+func parseFileTag(tag string) (fileOptions, error) {
+	var opts fileOptions
+	if tag == "" {
+		return opts, nil
+	}
+	for _, item := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(item), "=")
+		var err error
+		switch key {
+		case "max":
+			opts.maxSize, err = parseSize(value)
+		case "count":
+			opts.maxCount, err = strconv.Atoi(value)
+		case "strictname":
+			opts.strictName = true
+		case "checksum":
+			opts.checksum = strings.ToLower(strings.TrimSpace(value))
+			if _, ok := checksumAlgorithms[opts.checksum]; !ok {
+				err = fmt.Errorf("unknown checksum algorithm %q", value)
+			}
+		case "checksumfield":
+			opts.checksumField = strings.TrimSpace(value)
+		case "types":
+			for _, t := range strings.Split(value, "|") {
+				opts.types = append(opts.types, strings.ToLower(strings.TrimSpace(t)))
+			}
+		case "ext":
+			for _, e := range strings.Split(value, "|") {
+				e = strings.ToLower(strings.TrimSpace(e))
+				if !strings.HasPrefix(e, ".") {
+					e = "." + e
+				}
+				opts.exts = append(opts.exts, e)
+			}
+		default:
+			err = fmt.Errorf("unknown file tag option %q", key)
+		}
+		if err != nil {
+			return opts, err
+		}
+	}
+	if opts.checksumField != "" && opts.checksum == "" {
+		return opts, errors.New("checksumfield requires checksum")
+	}
+	return opts, nil
+}
+
+// parseSize - "512", "10KB", "5MB", "1GB" 형식의 크기를 바이트 수로 변환합니다. 단위는 1024 배수입니다.
+// parseSize - Converts sizes such as "512", "10KB", "5MB" or "1GB" to bytes. Units are multiples of 1024.
+func parseSize(s string) (int64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	units := []struct {
+		suffix string
+		mult   int64
+	}{
+		{"KIB", 1 << 10}, {"MIB", 1 << 20}, {"GIB", 1 << 30},
+		{"KB", 1 << 10}, {"MB", 1 << 20}, {"GB", 1 << 30},
+		{"K", 1 << 10}, {"M", 1 << 20}, {"G", 1 << 30},
+		{"B", 1},
+	}
+	mult := int64(1)
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			s, mult = strings.TrimSpace(strings.TrimSuffix(s, u.suffix)), u.mult
+			break
+		}
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	if n > math.MaxInt64/mult {
+		return 0, fmt.Errorf("size %q overflows int64", s)
+	}
+	return n * mult, nil
+}
+
+// readMultipartForm - 멀티파트 본문을 파트 단위로 읽으면서 전역 제한과 필드별 count=, max= 제한을 적용하고, 통과한 파트만
+// multipart.Reader.ReadForm에 넘겨 메모리나 디스크에 보관합니다. 제한을 넘는 파트를 만나면 그 자리에서 읽기를 멈추므로,
+// maxMemory는 디스크로 넘길 기준일 뿐이고 클라이언트가 올릴 수 있는 양은 제한이 정합니다.
+// 파싱한 값은 r.ParseMultipartForm과 같이 r.MultipartForm, r.Form, r.PostForm에 담깁니다.
+// readMultipartForm - Reads a multipart body part by part while applying the global limits and the per-field count= and max= limits,
+// handing only the parts that pass to multipart.Reader.ReadForm, which keeps them in memory or on disk. Reading stops at the first part
+// over a limit, so maxMemory only decides when to spill to disk, while the limits decide what the client may upload.
+// As with r.ParseMultipartForm, the parsed values are stored in r.MultipartForm, r.Form and r.PostForm.
+func readMultipartForm(r *http.Request, v any, cfg *config) error {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return err
+	}
+	var rt reflect.Type
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Struct {
+		rt = rv.Elem().Type()
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	type result struct {
+		form *multipart.Form
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		form, err := multipart.NewReader(pr, mw.Boundary()).ReadForm(cfg.maxMultipartMemory)
+		pr.CloseWithError(err) // ReadForm이 먼저 실패하면 쓰는 쪽을 깨웁니다.
+		done <- result{form, err}
+	}()
+	err = copyLimitedParts(mr, mw, rt, cfg)
+	if err == nil {
+		err = mw.Close()
+	}
+	pw.CloseWithError(err)
+	res := <-done
+	if err != nil {
+		if res.form != nil {
+			res.form.RemoveAll()
+		}
+		return uploadReadError(err)
+	}
+	if res.err != nil {
+		return uploadReadError(res.err)
+	}
+	if err := r.ParseForm(); err != nil {
+		res.form.RemoveAll()
+		return err
+	}
+	r.MultipartForm = res.form
+	for key, values := range res.form.Value {
+		r.Form[key] = append(r.Form[key], values...)
+		r.PostForm[key] = append(r.PostForm[key], values...)
+	}
+	return nil
+}
+
+// copyLimitedParts - mr의 파트를 제한을 적용하며 mw로 복사합니다. rt는 파일 필드의 태그 옵션을 찾을 구조체 타입이며 nil일 수 있습니다.
+// copyLimitedParts - Copies the parts of mr to mw while applying the limits. rt is the struct type used to find file field tag options, and may be nil.
+func copyLimitedParts(mr *multipart.Reader, mw *multipart.Writer, rt reflect.Type, cfg *config) error {
+	limits := cfg.uploadLimits
+	fileCount, fieldCount := 0, 0
+	perField := map[string]int{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := part.FormName()
+		if name == "" {
+			continue
+		}
+		var maxSize int64
+		if part.FileName() == "" {
+			fieldCount++
+			if limits.MaxFields > 0 && fieldCount > limits.MaxFields {
+				return BindError{Field: name, Err: fmt.Errorf("%w: limit is %d", ErrTooManyFields, limits.MaxFields)}
+			}
+		} else {
+			fileCount++
+			if limits.MaxFiles > 0 && fileCount > limits.MaxFiles {
+				return BindError{Field: name, Err: fmt.Errorf("%w: limit is %d", ErrTooManyFiles, limits.MaxFiles)}
+			}
+			maxSize = limits.MaxFileSize
+			if segs, ok := parseFileKey(name); ok && rt != nil {
+				ff, err := lookupFileField(rt, segs)
+				if err != nil {
+					return BindError{Field: name, Err: err}
+				}
+				if ff != nil {
+					opts := ff.options(cfg)
+					perField[name]++
+					if opts.maxCount > 0 && perField[name] > opts.maxCount {
+						return BindError{Field: name, Err: fmt.Errorf("%w: got more than %d", ErrTooManyFiles, opts.maxCount)}
+					}
+					if opts.maxSize > 0 && (maxSize == 0 || opts.maxSize < maxSize) {
+						maxSize = opts.maxSize
+					}
+				}
+			}
+		}
+		w, err := mw.CreatePart(part.Header)
+		if err != nil {
+			return err
+		}
+		var src io.Reader = part
+		if maxSize > 0 {
+			src = io.LimitReader(part, maxSize+1)
+		}
+		n, err := io.Copy(w, src)
+		if err != nil {
+			return err
+		}
+		if maxSize > 0 && n > maxSize {
+			return BindError{Field: name, Err: fmt.Errorf("%w: %q exceeds limit of %d bytes", ErrFileTooLarge, part.FileName(), maxSize)}
+		}
+	}
+}
+
+// checkFileOptions - 필드별 `file` 태그 제한을 검사합니다.
+// checkFileOptions - Checks the per-field `file` tag limits.
+func checkFileOptions(name string, files []*multipart.FileHeader, opts fileOptions) error {
+	if opts.maxCount > 0 && len(files) > opts.maxCount {
+		return BindError{Field: name, Err: fmt.Errorf("%w: got %d, limit is %d", ErrTooManyFiles, len(files), opts.maxCount)}
+	}
+	for _, fh := range files {
+		if opts.strictName {
+			if err := ValidateFilename(rawFilename(fh.Header)); err != nil {
+				return BindError{Field: name, Err: err}
+			}
+		}
+		if opts.maxSize > 0 && fh.Size > opts.maxSize {
+			return BindError{Field: name, Err: fmt.Errorf("%w: %q is %d bytes, limit is %d", ErrFileTooLarge, fh.Filename, fh.Size, opts.maxSize)}
+		}
+		if err := checkFileType(fh, opts); err != nil {
+			return BindError{Field: name, Err: err}
+		}
+	}
+	return nil
+}
+
+// rawFilename - Content-Disposition 헤더의 filename 파라미터를 그대로 반환합니다.
+// multipart는 경로를 잘라 낸 이름만 돌려주므로, "../a.txt"처럼 안전하지 않은 원본 이름을 검사하려면 헤더를 직접 읽어야 합니다.
+// rawFilename - Returns the filename parameter of the Content-Disposition header as sent.
+// multipart only returns the base name, so the header must be read directly to check unsafe raw names such as "../a.txt".
+func rawFilename(h textproto.MIMEHeader) string {
+	_, params, err := mime.ParseMediaType(h.Get("Content-Disposition"))
+	if err != nil {
+		return ""
+	}
+	return params["filename"]
+}
+
+// checkFileType - 파일의 확장자, 선언된 Content-Type, 내용으로 추정한 타입이 모두 허용 목록에 있는지 검사합니다.
+// 선언된 타입은 클라이언트가 임의로 정할 수 있으므로 앞 512바이트를 직접 검사한 결과와 함께 확인합니다.
+// checkFileType - Checks that the file's extension, declared Content-Type and sniffed type are all in the allowlists.
+// The declared type is client-controlled, so it is checked together with a sniff of the first 512 bytes.
+func checkFileType(fh *multipart.FileHeader, opts fileOptions) error {
+	if err := checkDeclaredType(fh.Filename, fh.Header, opts); err != nil {
+		return err
+	}
+	if len(opts.types) == 0 {
+		return nil
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	buf := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if sniffed := http.DetectContentType(buf[:n]); !matchMediaType(opts.types, sniffed) {
+		return fmt.Errorf("%w: detected type %q of %q", ErrFileTypeNotAllowed, sniffed, fh.Filename)
+	}
+	return nil
+}
+
+// checkDeclaredType - 파일 이름 확장자와 선언된 Content-Type을 허용 목록과 비교합니다.
+// checkDeclaredType - Checks the filename extension and declared Content-Type against the allowlists.
+func checkDeclaredType(filename string, header textproto.MIMEHeader, opts fileOptions) error {
+	if len(opts.exts) > 0 {
+		ext := strings.ToLower(filepath.Ext(filename))
+		if !slices.Contains(opts.exts, ext) {
+			return fmt.Errorf("%w: extension %q of %q", ErrFileTypeNotAllowed, ext, filename)
+		}
+	}
+	if declared := header.Get("Content-Type"); declared != "" && len(opts.types) > 0 {
+		if !matchMediaType(opts.types, declared) {
+			return fmt.Errorf("%w: declared type %q of %q", ErrFileTypeNotAllowed, declared, filename)
+		}
+	}
+	return nil
+}
+
+// matchMediaType - 파라미터를 제외한 미디어 타입이 허용 목록과 일치하는지 확인합니다. "image/*" 같은 와일드카드를 지원합니다.
+// matchMediaType - Reports whether the media type, without parameters, matches the allowlist. Supports wildcards such as "image/*".
+func matchMediaType(allowed []string, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, a := range allowed {
+		if a == mediaType || (strings.HasSuffix(a, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(a, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys - 에러 보고가 항상 같은 필드를 가리키도록 맵의 키를 정렬해 반환합니다.
+// sortedKeys - Returns the map keys sorted so that error reports always name the same field.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,788 @@
+package bind_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DevNewbie1826/bind"
+)
+
+// --- 테스트용 구조체 정의 ---
+
+type LimitedUploadPayload struct {
+	Name   string                  `form:"name"`
+	Avatar *multipart.FileHeader   `form:"avatar" file:"max=1KB,count=1"`
+	Photos []*multipart.FileHeader `form:"photos" file:"count=2"`
+}
+
+func (p *LimitedUploadPayload) Bind(r *http.Request) error { return nil }
+
+type ImageUploadPayload struct {
+	Image *multipart.FileHeader `form:"image" file:"types=image/png|image/jpeg,ext=.png|.jpg"`
+}
+
+func (p *ImageUploadPayload) Bind(r *http.Request) error { return nil }
+
+type StreamingUploadPayload struct {
+	Name   string           `form:"name"`
+	Tags   []string         `form:"tags"`
+	Avatar bind.PartHandler `form:"avatar"`
+	Blob   io.Writer        `form:"blob" file:"max=16"`
+}
+
+func (p *StreamingUploadPayload) Bind(r *http.Request) error { return nil }
+
+type StoredUploadPayload struct {
+	Name   string             `form:"name"`
+	Doc    *bind.StoredFile   `form:"doc"`
+	Extras []*bind.StoredFile `form:"extras"`
+	fail   bool
+}
+
+func (p *StoredUploadPayload) Bind(r *http.Request) error {
+	if p.fail {
+		return errors.New("rejected")
+	}
+	return nil
+}
+
+type UploadDocument struct {
+	Name string                `form:"name"`
+	File *multipart.FileHeader `form:"file" file:"max=1KB"`
+}
+
+type UploadProfile struct {
+	Avatar *bind.StoredFile `form:"avatar"`
+}
+
+type UploadAttachments struct {
+	Attachment *multipart.FileHeader `form:"attachment"`
+}
+
+type NestedUploadPayload struct {
+	UploadAttachments
+	Title     string           `form:"title"`
+	Documents []UploadDocument `form:"documents"`
+	Profile   *UploadProfile   `form:"profile"`
+}
+
+func (p *NestedUploadPayload) Bind(r *http.Request) error { return nil }
+
+type FileTypesPayload struct {
+	Raw    []byte         `form:"raw" file:"max=8"`
+	Reader io.ReadCloser  `form:"reader"`
+	File   multipart.File `form:"file"`
+	Doc    *bind.File     `form:"doc"`
+	Docs   []*bind.File   `form:"docs"`
+}
+
+func (p *FileTypesPayload) Bind(r *http.Request) error { return nil }
+
+type StrictNamePayload struct {
+	Strict *bind.File `form:"strict" file:"strictname"`
+	Loose  *bind.File `form:"loose"`
+}
+
+func (p *StrictNamePayload) Bind(r *http.Request) error { return nil }
+
+type ChecksumPayload struct {
+	DocSHA string           `form:"doc_sha256"`
+	Doc    *bind.StoredFile `form:"doc" file:"checksum=sha256,checksumfield=doc_sha256"`
+	Files  []*bind.File     `form:"files" file:"checksum=md5"`
+}
+
+func (p *ChecksumPayload) Bind(r *http.Request) error { return nil }
+
+type BadFileTagPayload struct {
+	File *multipart.FileHeader `form:"file" file:"max=lots"`
+}
+
+func (p *BadFileTagPayload) Bind(r *http.Request) error { return nil }
+
+type OverflowFileTagPayload struct {
+	File *multipart.FileHeader `form:"file" file:"max=9999999999GB"`
+}
+
+func (p *OverflowFileTagPayload) Bind(r *http.Request) error { return nil }
+
+// cutoffReader - off 바이트를 넘겨 읽으면 에러를 반환하는 io.Reader
+type cutoffReader struct {
+	r   io.Reader
+	off int
+}
+
+func (c *cutoffReader) Read(p []byte) (int, error) {
+	if c.off <= 0 {
+		return 0, errors.New("read past the cutoff")
+	}
+	n, err := c.r.Read(p[:min(len(p), c.off)])
+	c.off -= n
+	return n, err
+}
+
+// uploadPart - 테스트 요청에 포함될 멀티파트 파트
+type uploadPart struct {
+	field       string
+	filename    string // 비어 있으면 일반 필드
+	content     string
+	contentType string // 비어 있으면 application/octet-stream
+	header      map[string]string
+}
+
+// newMultipartRequest - 주어진 파트들로 멀티파트 요청을 생성합니다.
+func newMultipartRequest(t *testing.T, parts ...uploadPart) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, p := range parts {
+		if p.filename == "" {
+			writer.WriteField(p.field, p.content)
+			continue
+		}
+		ct := p.contentType
+		if ct == "" {
+			ct = "application/octet-stream"
+		}
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, p.field, p.filename))
+		h.Set("Content-Type", ct)
+		for k, v := range p.header {
+			h.Set(k, v)
+		}
+		w, err := writer.CreatePart(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(p.content))
+	}
+	writer.Close()
+	req, _ := http.NewRequest("POST", "/", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+// expectFieldError - 에러가 target을 래핑하고 field를 가리키는지 확인합니다.
+func expectFieldError(t *testing.T, err, target error, field string) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Fatalf("expected %v, got %v", target, err)
+	}
+	var bindErr bind.BindError
+	if !errors.As(err, &bindErr) || bindErr.Field != field {
+		t.Errorf("expected error on field %q, got %q", field, bindErr.Field)
+	}
+}
+
+// --- 테스트 함수 ---
+
+func TestUpload_FieldTagLimits(t *testing.T) {
+	small := string(bytes.Repeat([]byte("a"), 512))
+	large := string(bytes.Repeat([]byte("a"), 2048))
+
+	req := newMultipartRequest(t,
+		uploadPart{field: "avatar", filename: "a.png", content: small},
+		uploadPart{field: "photos", filename: "1.jpg", content: "1"},
+		uploadPart{field: "photos", filename: "2.jpg", content: "2"},
+	)
+	payload := &LimitedUploadPayload{}
+	if err := bind.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Avatar == nil || len(payload.Photos) != 2 {
+		t.Errorf("expected files within limits to be bound, got %+v", payload)
+	}
+
+	req = newMultipartRequest(t, uploadPart{field: "avatar", filename: "a.png", content: large})
+	expectFieldError(t, bind.Action(req, &LimitedUploadPayload{}), bind.ErrFileTooLarge, "avatar")
+
+	req = newMultipartRequest(t,
+		uploadPart{field: "avatar", filename: "a.png", content: small},
+		uploadPart{field: "avatar", filename: "b.png", content: small},
+	)
+	expectFieldError(t, bind.Action(req, &LimitedUploadPayload{}), bind.ErrTooManyFiles, "avatar")
+
+	req = newMultipartRequest(t,
+		uploadPart{field: "photos", filename: "1.jpg", content: "1"},
+		uploadPart{field: "photos", filename: "2.jpg", content: "2"},
+		uploadPart{field: "photos", filename: "3.jpg", content: "3"},
+	)
+	expectFieldError(t, bind.Action(req, &LimitedUploadPayload{}), bind.ErrTooManyFiles, "photos")
+}
+
+func TestUpload_GlobalLimits(t *testing.T) {
+	original := bind.GetUploadLimits()
+	t.Cleanup(func() { bind.SetUploadLimits(original) })
+
+	bind.SetUploadLimits(bind.UploadLimits{MaxFiles: 1})
+	req := newMultipartRequest(t,
+		uploadPart{field: "file", filename: "1.txt", content: "1"},
+		uploadPart{field: "files", filename: "2.txt", content: "2"},
+	)
+	expectFieldError(t, bind.Action(req, &FileUploadPayload{}), bind.ErrTooManyFiles, "files")
+
+	bind.SetUploadLimits(bind.UploadLimits{MaxFields: 1})
+	req = newMultipartRequest(t,
+		uploadPart{field: "name", content: "a"},
+		uploadPart{field: "value", content: "1"},
+	)
+	expectFieldError(t, bind.Action(req, &TestPayload{}), bind.ErrTooManyFields, "value")
+
+	bind.SetUploadLimits(bind.UploadLimits{MaxFileSize: 4})
+	req = newMultipartRequest(t, uploadPart{field: "file", filename: "1.txt", content: "too large"})
+	expectFieldError(t, bind.Action(req, &FileUploadPayload{}), bind.ErrFileTooLarge, "file")
+}
+
+func TestUpload_MaxTotalSize(t *testing.T) {
+	req := newMultipartRequest(t, uploadPart{field: "file", filename: "1.txt", content: string(bytes.Repeat([]byte("a"), 4096))})
+	err := bind.Action(req, &FileUploadPayload{}, bind.WithUploadLimits(bind.UploadLimits{MaxTotalSize: 1024}))
+	if !errors.Is(err, bind.ErrUploadTooLarge) {
+		t.Errorf("expected total size error, got %v", err)
+	}
+}
+
+func TestUpload_LimitsWhileReading(t *testing.T) {
+	filler := string(bytes.Repeat([]byte("a"), 64<<10))
+	cases := []struct {
+		limits bind.UploadLimits
+		parts  []uploadPart
+		target error
+		field  string
+	}{
+		{bind.UploadLimits{MaxFiles: 1}, []uploadPart{
+			{field: "file", filename: "1.txt", content: "1"},
+			{field: "files", filename: "2.txt", content: filler},
+		}, bind.ErrTooManyFiles, "files"},
+		{bind.UploadLimits{MaxFileSize: 1024}, []uploadPart{
+			{field: "file", filename: "1.txt", content: filler},
+		}, bind.ErrFileTooLarge, "file"},
+	}
+	for _, tc := range cases {
+		// 제한을 넘는 파트의 나머지를 읽으면 에러가 나는 본문으로, 읽는 도중에 멈추는지 확인합니다.
+		req := newMultipartRequest(t, tc.parts...)
+		total := int(req.ContentLength)
+		if total <= 0 {
+			body, _ := io.ReadAll(req.Body)
+			total = len(body)
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		req.Body = io.NopCloser(&cutoffReader{r: req.Body, off: total - 32<<10})
+		expectFieldError(t, bind.Action(req, &FileUploadPayload{}, bind.WithUploadLimits(tc.limits)), tc.target, tc.field)
+	}
+}
+
+func TestUpload_InvalidFileTag(t *testing.T) {
+	req := newMultipartRequest(t, uploadPart{field: "file", filename: "1.txt", content: "1"})
+	if err := bind.Action(req, &BadFileTagPayload{}); err == nil {
+		t.Error("expected error for invalid file tag, got nil")
+	}
+	req = newMultipartRequest(t, uploadPart{field: "file", filename: "1.txt", content: "1"})
+	if err := bind.Action(req, &OverflowFileTagPayload{}); err == nil || !strings.Contains(err.Error(), "overflows") {
+		t.Errorf("expected overflow error for the file tag, got %v", err)
+	}
+}
+
+func TestUpload_TypeAllowlist(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n" + "rest of image"
+
+	req := newMultipartRequest(t, uploadPart{field: "image", filename: "a.png", content: png, contentType: "image/png"})
+	payload := &ImageUploadPayload{}
+	if err := bind.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Image == nil {
+		t.Fatal("expected image to be bound")
+	}
+
+	testCases := []struct {
+		name string
+		part uploadPart
+	}{
+		{"extension", uploadPart{field: "image", filename: "a.gif", content: png, contentType: "image/png"}},
+		{"declared type", uploadPart{field: "image", filename: "a.png", content: png, contentType: "image/gif"}},
+		{"sniffed type", uploadPart{field: "image", filename: "a.png", content: "<html>not an image</html>", contentType: "image/png"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newMultipartRequest(t, tc.part)
+			expectFieldError(t, bind.Action(req, &ImageUploadPayload{}), bind.ErrFileTypeNotAllowed, "image")
+		})
+	}
+}
+
+func TestUpload_StreamingMultipart(t *testing.T) {
+	req := newMultipartRequest(t,
+		uploadPart{field: "name", content: "alice"},
+		uploadPart{field: "tags", content: "a"},
+		uploadPart{field: "avatar", filename: "a.png", content: "avatar-bytes"},
+		uploadPart{field: "tags", content: "b"},
+		uploadPart{field: "blob", filename: "b.bin", content: "blob-bytes"},
+		uploadPart{field: "unknown", filename: "c.bin", content: "ignored"},
+		uploadPart{field: "name", content: "bob"},
+	)
+
+	var order []string
+	var avatar []byte
+	blob := &bytes.Buffer{}
+	payload := &StreamingUploadPayload{Blob: blob}
+	payload.Avatar = func(part *multipart.Part) error {
+		// 앞서 전송된 값 파트는 이미 바인딩되어 있어야 합니다.
+		order = append(order, "avatar:"+payload.Name)
+		var err error
+		avatar, err = io.ReadAll(part)
+		return err
+	}
+
+	if err := bind.Action(req, payload, bind.WithStreamingMultipart(true)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(order) != 1 || order[0] != "avatar:alice" {
+		t.Errorf("expected handler to see preceding fields, got %v", order)
+	}
+	if string(avatar) != "avatar-bytes" || blob.String() != "blob-bytes" {
+		t.Errorf("unexpected streamed content: %q, %q", avatar, blob.String())
+	}
+	if len(payload.Tags) != 2 || payload.Tags[0] != "a" || payload.Tags[1] != "b" {
+		t.Errorf("expected tags [a b], got %v", payload.Tags)
+	}
+	if payload.Name != "bob" {
+		t.Errorf("expected a value after a file part to overwrite the scalar, got %q", payload.Name)
+	}
+}
+
+func TestUpload_StreamingMultipartLimits(t *testing.T) {
+	req := newMultipartRequest(t, uploadPart{field: "blob", filename: "b.bin", content: "more than sixteen bytes"})
+	payload := &StreamingUploadPayload{Blob: io.Discard}
+	err := bind.Action(req, payload, bind.WithStreamingMultipart(true))
+	expectFieldError(t, err, bind.ErrFileTooLarge, "blob")
+
+	handlerErr := errors.New("handler failed")
+	req = newMultipartRequest(t, uploadPart{field: "avatar", filename: "a.png", content: "x"})
+	payload = &StreamingUploadPayload{Avatar: func(*multipart.Part) error { return handlerErr }}
+	err = bind.Action(req, payload, bind.WithStreamingMultipart(true))
+	expectFieldError(t, err, handlerErr, "avatar")
+
+	// 핸들러가 읽는 파트에도 크기 제한이 적용되며, 핸들러가 읽기 에러를 무시해도 바인딩은 실패합니다.
+	var got []byte
+	for _, handler := range []bind.PartHandler{
+		func(p *multipart.Part) error { _, err := io.Copy(io.Discard, p); return err },
+		func(p *multipart.Part) error { got, _ = io.ReadAll(p); return nil },
+	} {
+		req = newMultipartRequest(t, uploadPart{field: "avatar", filename: "a.png", content: strings.Repeat("x", 64)})
+		err = bind.Action(req, &StreamingUploadPayload{Avatar: handler}, bind.WithStreamingMultipart(true), bind.WithUploadLimits(bind.UploadLimits{MaxFileSize: 8}))
+		expectFieldError(t, err, bind.ErrFileTooLarge, "avatar")
+	}
+	if len(got) > 9 {
+		t.Errorf("expected the handler to read at most the limit, got %d bytes", len(got))
+	}
+}
+
+func TestUpload_FileStore(t *testing.T) {
+	content := "%PDF-1.4 document"
+	sum := sha256.Sum256([]byte(content))
+
+	for _, streaming := range []bool{false, true} {
+		t.Run(fmt.Sprintf("streaming=%v", streaming), func(t *testing.T) {
+			store := bind.NewMemoryStore()
+			req := newMultipartRequest(t,
+				uploadPart{field: "doc", filename: "a.pdf", content: content},
+				uploadPart{field: "name", content: "report"},
+				uploadPart{field: "extras", filename: "1.txt", content: "one"},
+				uploadPart{field: "extras", filename: "2.txt", content: "two"},
+			)
+			payload := &StoredUploadPayload{}
+			err := bind.Action(req, payload, bind.WithFileStore(store), bind.WithStreamingMultipart(streaming))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.Name != "report" || payload.Doc == nil || len(payload.Extras) != 2 {
+				t.Fatalf("stored file binding failed, got %+v", payload)
+			}
+			doc := payload.Doc
+			if doc.Filename != "a.pdf" || doc.Size != int64(len(content)) || doc.ContentType != "application/pdf" || doc.SHA256 != hex.EncodeToString(sum[:]) {
+				t.Errorf("unexpected stored file metadata: %+v", doc)
+			}
+			rc, err := store.Open(doc.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rc.Close()
+			if b, _ := io.ReadAll(rc); string(b) != content {
+				t.Errorf("expected stored content %q, got %q", content, b)
+			}
+			if store.Len() != 3 {
+				t.Errorf("expected 3 stored files, got %d", store.Len())
+			}
+		})
+	}
+}
+
+func TestUpload_FileStoreRollback(t *testing.T) {
+	dir := t.TempDir()
+	store, err := bind.NewDirStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := newMultipartRequest(t,
+		uploadPart{field: "doc", filename: "a.txt", content: "doc"},
+		uploadPart{field: "extras", filename: "1.txt", content: "one"},
+	)
+	err = bind.Action(req, &StoredUploadPayload{fail: true}, bind.WithFileStore(store))
+	if err == nil {
+		t.Fatal("expected bind error, got nil")
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("expected stored files to be removed after failed binding, found %d", len(entries))
+	}
+}
+
+// shortReadStore - 파일의 처음 몇 바이트만 읽고 저장에 성공하는 FileStore
+type shortReadStore struct {
+	*bind.MemoryStore
+}
+
+func (s shortReadStore) Save(ctx context.Context, filename string, src io.Reader) (string, error) {
+	return s.MemoryStore.Save(ctx, filename, io.LimitReader(src, 4))
+}
+
+func TestUpload_FileStoreShortRead(t *testing.T) {
+	store := shortReadStore{bind.NewMemoryStore()}
+	req := newMultipartRequest(t, uploadPart{field: "doc", filename: "a.txt", content: strings.Repeat("a", 64<<10)})
+	err := bind.Action(req, &StoredUploadPayload{}, bind.WithFileStore(store), bind.WithStreamingMultipart(true))
+	if err == nil || !strings.Contains(err.Error(), "before reading") {
+		t.Fatalf("expected error for a store that stops reading early, got %v", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("expected the truncated file to be removed, found %d", store.Len())
+	}
+}
+
+func TestUpload_FileStoreMissing(t *testing.T) {
+	req := newMultipartRequest(t, uploadPart{field: "doc", filename: "a.txt", content: "doc"})
+	expectFieldError(t, bind.Action(req, &StoredUploadPayload{}), bind.ErrNoFileStore, "doc")
+}
+
+// tempDirEntries - 테스트용 임시 디렉터리에 남아 있는 파일 수를 반환합니다.
+func tempDirEntries(t *testing.T, dir string) int {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(entries)
+}
+
+func TestUpload_CleanupFunc(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+
+	req := newMultipartRequest(t, uploadPart{field: "file", filename: "big.bin", content: string(bytes.Repeat([]byte("x"), 4096))})
+	payload := &FileUploadPayload{}
+	cleanup, err := bind.ActionWithCleanup(req, payload, bind.WithMaxMultipartMemory(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tempDirEntries(t, dir) == 0 {
+		t.Fatal("expected the upload to spill into a temporary file")
+	}
+	if err := cleanup(); err != nil {
+		t.Fatalf("cleanup failed: %v", err)
+	}
+	if n := tempDirEntries(t, dir); n != 0 {
+		t.Errorf("expected no files in %s after cleanup, found %d", dir, n)
+	}
+	if err := cleanup(); err != nil {
+		t.Errorf("expected repeated cleanup to be a no-op, got %v", err)
+	}
+}
+
+func TestUpload_AutoCleanup(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := newMultipartRequest(t, uploadPart{field: "file", filename: "big.bin", content: string(bytes.Repeat([]byte("x"), 4096))})
+	req = req.WithContext(ctx)
+	if err := bind.Action(req, &FileUploadPayload{}, bind.WithMaxMultipartMemory(1), bind.WithAutoCleanup(true)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tempDirEntries(t, dir) == 0 {
+		t.Fatal("expected the upload to spill into a temporary file")
+	}
+
+	// 요청 컨텍스트가 끝나면 정리 작업이 비동기로 실행됩니다.
+	cancel()
+	deadline := time.Now().Add(2 * time.Second)
+	for tempDirEntries(t, dir) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected no files in %s after the request context ended", dir)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestUpload_NestedFiles(t *testing.T) {
+	for _, streaming := range []bool{false, true} {
+		t.Run(fmt.Sprintf("streaming=%v", streaming), func(t *testing.T) {
+			req := newMultipartRequest(t,
+				uploadPart{field: "title", content: "batch"},
+				uploadPart{field: "documents[0].name", content: "first"},
+				uploadPart{field: "documents[0].file", filename: "a.txt", content: "a"},
+				uploadPart{field: "documents[1].name", content: "second"},
+				uploadPart{field: "documents[1].file", filename: "b.txt", content: "b"},
+				uploadPart{field: "documents[7].unknown", filename: "c.txt", content: "c"},
+				uploadPart{field: "profile.avatar", filename: "me.png", content: "avatar"},
+				uploadPart{field: "attachment", filename: "d.txt", content: "d"},
+			)
+			payload := &NestedUploadPayload{}
+			opts := []bind.Option{bind.WithFileStore(bind.NewMemoryStore()), bind.WithStreamingMultipart(streaming)}
+			if err := bind.Action(req, payload, opts...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.Title != "batch" || len(payload.Documents) != 2 {
+				t.Fatalf("expected 2 documents, got %+v", payload.Documents)
+			}
+			if payload.Profile == nil || payload.Profile.Avatar == nil || payload.Profile.Avatar.Filename != "me.png" {
+				t.Errorf("nested pointer struct binding failed, got %+v", payload.Profile)
+			}
+			if streaming {
+				// 스트리밍 모드에서는 *multipart.FileHeader 필드가 채워지지 않습니다.
+				return
+			}
+			for i, want := range []struct{ name, file string }{{"first", "a.txt"}, {"second", "b.txt"}} {
+				doc := payload.Documents[i]
+				if doc.Name != want.name || doc.File == nil || doc.File.Filename != want.file {
+					t.Errorf("document %d: expected {%s %s}, got %+v", i, want.name, want.file, doc)
+				}
+			}
+			if payload.Attachment == nil || payload.Attachment.Filename != "d.txt" {
+				t.Errorf("embedded struct binding failed, got %+v", payload.Attachment)
+			}
+		})
+	}
+}
+
+func TestUpload_NestedFileLimits(t *testing.T) {
+	req := newMultipartRequest(t, uploadPart{field: "documents[3].file", filename: "a.txt", content: string(bytes.Repeat([]byte("a"), 2048))})
+	expectFieldError(t, bind.Action(req, &NestedUploadPayload{}), bind.ErrFileTooLarge, "documents[3].file")
+}
+
+func TestUpload_FileFieldTypes(t *testing.T) {
+	req := newMultipartRequest(t,
+		uploadPart{field: "raw", filename: "raw.bin", content: "raw"},
+		uploadPart{field: "reader", filename: "r.txt", content: "reader"},
+		uploadPart{field: "file", filename: "f.txt", content: "file"},
+		uploadPart{field: "doc", filename: `..\..\evil\doc.txt`, content: "doc", contentType: "text/plain"},
+		uploadPart{field: "docs", filename: "1.txt", content: "1"},
+		uploadPart{field: "docs", filename: "2.txt", content: "2"},
+	)
+	payload := &FileTypesPayload{}
+	cleanup, err := bind.ActionWithCleanup(req, payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(payload.Raw) != "raw" {
+		t.Errorf("expected raw bytes %q, got %q", "raw", payload.Raw)
+	}
+	if b, _ := io.ReadAll(payload.Reader); string(b) != "reader" {
+		t.Errorf("expected reader content %q, got %q", "reader", b)
+	}
+	if b, _ := io.ReadAll(payload.File); string(b) != "file" {
+		t.Errorf("expected file content %q, got %q", "file", b)
+	}
+	doc := payload.Doc
+	if doc == nil || doc.Size != 3 || doc.ContentType() != "text/plain" || doc.SafeFilename() != "doc.txt" {
+		t.Fatalf("unexpected bind.File: %+v", doc)
+	}
+	f, err := doc.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := io.ReadAll(f); string(b) != "doc" {
+		t.Errorf("expected doc content %q, got %q", "doc", b)
+	}
+	f.Close()
+	if len(payload.Docs) != 2 || payload.Docs[1].Filename != "2.txt" {
+		t.Errorf("expected 2 docs, got %+v", payload.Docs)
+	}
+	if err := cleanup(); err != nil {
+		t.Errorf("cleanup failed: %v", err)
+	}
+}
+
+func TestUpload_BytesSizeCap(t *testing.T) {
+	for _, streaming := range []bool{false, true} {
+		req := newMultipartRequest(t, uploadPart{field: "raw", filename: "raw.bin", content: "more than eight bytes"})
+		err := bind.Action(req, &FileTypesPayload{}, bind.WithStreamingMultipart(streaming))
+		expectFieldError(t, err, bind.ErrFileTooLarge, "raw")
+	}
+}
+
+func TestSanitizeFilename(t *testing.T) {
+	long := strings.Repeat("가", 100) + ".pdf"
+	tests := []struct {
+		name, in, want string
+	}{
+		{"plain", "report.pdf", "report.pdf"},
+		{"unix traversal", "../../etc/passwd", "passwd"},
+		{"windows traversal", `..\..\boot.ini`, "boot.ini"},
+		{"control chars", "a\x00b\nc.txt", "a_b_c.txt"},
+		{"windows chars", `a<b>:c?.txt`, "a_b__c_.txt"},
+		{"reserved name", "CON.txt", "_CON.txt"},
+		{"reserved lowercase", "lpt1", "_lpt1"},
+		{"trailing dots", " name.txt. ", "name.txt"},
+		{"only dots", "..", ""},
+		{"nfc", "cafe\u0301.txt", "caf\u00e9.txt"},
+		{"invalid utf8", "a\xffb.txt", "a\ufffdb.txt"},
+		{"too long", long, strings.Repeat("가", 83) + ".pdf"},
+		{"too long cut at spaces", strings.Repeat("a", 200) + strings.Repeat(" ", 100) + ".pdf", strings.Repeat("a", 200) + ".pdf"},
+		{"too long cut at dots", "a" + strings.Repeat(". ", 200) + ".pdf", "a.pdf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bind.SanitizeFilename(tt.in)
+			if got != tt.want {
+				t.Errorf("SanitizeFilename(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if len(got) > bind.MaxFilenameLength {
+				t.Errorf("result is %d bytes, longer than %d", len(got), bind.MaxFilenameLength)
+			}
+		})
+	}
+}
+
+func TestValidateFilename(t *testing.T) {
+	for _, name := range []string{"report.pdf", "cafe\u0301.txt", "보고서.hwp"} {
+		if err := bind.ValidateFilename(name); err != nil {
+			t.Errorf("ValidateFilename(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range []string{"", "../x.txt", "a\x00.txt", "NUL", "a\xff.txt", strings.Repeat("a", 256)} {
+		if err := bind.ValidateFilename(name); !errors.Is(err, bind.ErrUnsafeFilename) {
+			t.Errorf("ValidateFilename(%q) = %v, want ErrUnsafeFilename", name, err)
+		}
+	}
+}
+
+func TestUpload_StrictFilenames(t *testing.T) {
+	for _, streaming := range []bool{false, true} {
+		newReq := func(field, filename string) *http.Request {
+			return newMultipartRequest(t, uploadPart{field: field, filename: filename, content: "x"})
+		}
+
+		payload := &StrictNamePayload{}
+		if err := bind.Action(newReq("loose", "CON.txt\t"), payload, bind.WithStreamingMultipart(streaming)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !streaming && payload.Loose.SafeFilename() != "_CON.txt_" {
+			t.Errorf("expected sanitized name %q, got %q", "_CON.txt_", payload.Loose.SafeFilename())
+		}
+
+		// multipart가 경로를 잘라 내기 전의 원본 이름을 검사해야 합니다.
+		for _, filename := range []string{"CON.txt\t", "../x.txt", `..\x.txt`} {
+			err := bind.Action(newReq("strict", filename), &StrictNamePayload{}, bind.WithStreamingMultipart(streaming))
+			expectFieldError(t, err, bind.ErrUnsafeFilename, "strict")
+		}
+
+		err := bind.Action(newReq("loose", "CON.txt\t"), &StrictNamePayload{}, bind.WithStreamingMultipart(streaming), bind.WithStrictFilenames(true))
+		expectFieldError(t, err, bind.ErrUnsafeFilename, "loose")
+	}
+}
+
+func TestUpload_Checksum(t *testing.T) {
+	sha := sha256.Sum256([]byte("document"))
+	docHex := hex.EncodeToString(sha[:])
+	for _, streaming := range []bool{false, true} {
+		t.Run(fmt.Sprintf("streaming=%v", streaming), func(t *testing.T) {
+			opts := func(store *bind.MemoryStore) []bind.Option {
+				return []bind.Option{bind.WithFileStore(store), bind.WithStreamingMultipart(streaming)}
+			}
+
+			store := bind.NewMemoryStore()
+			req := newMultipartRequest(t,
+				uploadPart{field: "doc_sha256", content: docHex},
+				uploadPart{field: "doc", filename: "doc.pdf", content: "document"},
+			)
+			payload := &ChecksumPayload{}
+			if err := bind.Action(req, payload, opts(store)...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.Doc == nil || payload.Doc.Checksum != docHex {
+				t.Fatalf("expected checksum %s, got %+v", docHex, payload.Doc)
+			}
+
+			store = bind.NewMemoryStore()
+			req = newMultipartRequest(t,
+				uploadPart{field: "doc_sha256", content: docHex},
+				uploadPart{field: "doc", filename: "doc.pdf", content: "tampered"},
+			)
+			expectFieldError(t, bind.Action(req, &ChecksumPayload{}, opts(store)...), bind.ErrChecksumMismatch, "doc")
+			if store.Len() != 0 {
+				t.Errorf("expected mismatched file to be removed, %d left", store.Len())
+			}
+
+			req = newMultipartRequest(t, uploadPart{field: "doc", filename: "doc.pdf", content: "document"})
+			expectFieldError(t, bind.Action(req, &ChecksumPayload{}, opts(store)...), bind.ErrChecksumMissing, "doc")
+
+			req = newMultipartRequest(t,
+				uploadPart{field: "doc_sha256", content: "not-a-digest"},
+				uploadPart{field: "doc", filename: "doc.pdf", content: "document"},
+			)
+			err := bind.Action(req, &ChecksumPayload{}, opts(store)...)
+			expectFieldError(t, err, bind.ErrInvalidChecksum, "doc")
+			if errors.Is(err, bind.ErrChecksumMismatch) || !strings.Contains(err.Error(), `"doc_sha256"`) {
+				t.Errorf("expected a format error naming doc_sha256, got %v", err)
+			}
+
+			// 다른 파일 파트를 사이에 두고 보낸 다이제스트도 찾아야 합니다.
+			one := md5.Sum([]byte("one"))
+			req = newMultipartRequest(t,
+				uploadPart{field: "doc_sha256", content: docHex},
+				uploadPart{field: "files", filename: "1.txt", content: "one", header: map[string]string{"Content-MD5": base64.StdEncoding.EncodeToString(one[:])}},
+				uploadPart{field: "doc", filename: "doc.pdf", content: "document"},
+			)
+			if err := bind.Action(req, &ChecksumPayload{}, opts(bind.NewMemoryStore())...); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUpload_ChecksumHeaders(t *testing.T) {
+	one, two := md5.Sum([]byte("one")), md5.Sum([]byte("two"))
+	req := newMultipartRequest(t,
+		uploadPart{field: "files", filename: "1.txt", content: "one", header: map[string]string{"Content-MD5": base64.StdEncoding.EncodeToString(one[:])}},
+		uploadPart{field: "files", filename: "2.txt", content: "two", header: map[string]string{"Digest": "SHA-256=abc, MD5=" + base64.StdEncoding.EncodeToString(two[:])}},
+	)
+	payload := &ChecksumPayload{}
+	if err := bind.Action(req, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payload.Files) != 2 || payload.Files[1].Checksum != hex.EncodeToString(two[:]) {
+		t.Fatalf("unexpected files: %+v", payload.Files)
+	}
+
+	req = newMultipartRequest(t,
+		uploadPart{field: "files", filename: "1.txt", content: "one", header: map[string]string{"Content-MD5": base64.StdEncoding.EncodeToString(two[:])}},
+	)
+	expectFieldError(t, bind.Action(req, &ChecksumPayload{}), bind.ErrChecksumMismatch, "files")
+
+	req = newMultipartRequest(t,
+		uploadPart{field: "files", filename: "1.txt", content: "one", header: map[string]string{"Content-MD5": "zz"}},
+	)
+	expectFieldError(t, bind.Action(req, &ChecksumPayload{}), bind.ErrInvalidChecksum, "files")
+}
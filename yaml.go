@@ -0,0 +1,348 @@
+package bind
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// errYAMLAliasExpansion - 앨리어스 확장으로 디코딩할 노드 수가 지나치게 많을 때 반환되는 에러
+// errYAMLAliasExpansion - Returned when alias expansion makes the number of nodes to decode excessive.
+var errYAMLAliasExpansion = errors.New("yaml: document contains excessive aliasing")
+
+var (
+	yamlUnmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// decodeYAMLRequest - YAML 본문을 디코딩합니다.
+// 구조체 필드는 yaml 태그, 없으면 json 태그, 둘 다 없으면 yaml.v3와 같이 소문자로 바꾼 필드 이름으로 찾으며, 키는 대소문자까지 정확히 일치해야 합니다.
+// 본문 크기 제한과 중첩 깊이 제한이 JSON과 같이 적용되며, 타입 오류는 필드 경로를 담은 BindError로 보고됩니다.
+// decodeYAMLRequest - Decodes a YAML body.
+// Struct fields are matched by the yaml tag, then the json tag, then the lowercased field name as in yaml.v3, and keys must match exactly, including case.
+// The body size and nesting depth limits apply as for JSON, and type errors are reported as BindErrors carrying the field path.
+func decodeYAMLRequest(r *http.Request, v any) error {
+	cfg := configFrom(r)
+	body := limitBody(r, cfg)
+	defer io.Copy(io.Discard, r.Body)
+	// yaml.v3는 읽기 에러를 문자열로만 보고하므로 본문을 먼저 읽어 크기 제한 초과를 구분합니다.
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return bodyReadError(err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("bind: non-pointer passed to YAML decoder")
+	}
+	// 필드 경로를 따라 내려가지 않는 값(any 등)도 제한되도록 문서 자체의 깊이를 먼저 확인합니다.
+	// 앨리어스로 확장되는 깊이는 디코딩하면서 확인합니다.
+	count, depth := measureYAML(&doc, 0)
+	if cfg.maxNesting > 0 && depth > cfg.maxNesting {
+		return fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, cfg.maxNesting)
+	}
+	d := &yamlDecoder{maxDepth: cfg.maxNesting, budget: 1000 + 10*count, strict: cfg.strictDecoding}
+	return d.decode(&doc, rv.Elem(), "", 0)
+}
+
+// yamlDecoder - YAML 노드 트리를 값에 디코딩하면서 경로, 깊이, 앨리어스 확장을 추적합니다.
+// 스칼라와 yaml.Unmarshaler, encoding.TextUnmarshaler를 구현한 타입은 yaml.v3에 맡깁니다.
+// yamlDecoder - Decodes a YAML node tree into a value while tracking the path, depth and alias expansion.
+// Scalars and types implementing yaml.Unmarshaler or encoding.TextUnmarshaler are left to yaml.v3.
+type yamlDecoder struct {
+	maxDepth int
+	budget   int
+	strict   bool
+}
+
+func (d *yamlDecoder) decode(n *yaml.Node, rv reflect.Value, path string, depth int) error {
+	if d.maxDepth > 0 && depth > d.maxDepth {
+		return BindError{Field: path, Err: fmt.Errorf("%w: document nesting limit is %d", ErrMaxDepthExceeded, d.maxDepth)}
+	}
+	if d.budget--; d.budget < 0 {
+		return BindError{Field: path, Err: errYAMLAliasExpansion}
+	}
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil
+		}
+		return d.decode(n.Content[0], rv, path, depth)
+	case yaml.AliasNode:
+		return d.decode(n.Alias, rv, path, depth)
+	}
+	if n.Kind == yaml.ScalarNode && n.ShortTag() == "!!null" {
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+	}
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return d.decode(n, rv.Elem(), path, depth)
+	}
+	if pt := reflect.PointerTo(rv.Type()); pt.Implements(yamlUnmarshalerType) || pt.Implements(textUnmarshalerType) {
+		return d.leaf(n, rv, path)
+	}
+	switch {
+	case n.Kind == yaml.MappingNode && rv.Kind() == reflect.Struct:
+		return d.decodeStruct(n, rv, path, depth)
+	case n.Kind == yaml.MappingNode && rv.Kind() == reflect.Map:
+		return d.decodeMap(n, rv, path, depth)
+	case n.Kind == yaml.SequenceNode && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array):
+		return d.decodeSequence(n, rv, path, depth)
+	}
+	return d.leaf(n, rv, path)
+}
+
+// leaf - 노드를 yaml.v3로 직접 디코딩하고 에러에 경로를 붙입니다.
+// leaf - Decodes the node directly with yaml.v3 and attaches the path to errors.
+func (d *yamlDecoder) leaf(n *yaml.Node, rv reflect.Value, path string) error {
+	if err := n.Decode(rv.Addr().Interface()); err != nil {
+		return BindError{Field: path, Err: err}
+	}
+	return nil
+}
+
+// decodeStruct - 매핑 노드를 구조체에 디코딩합니다. 병합 키("<<")는 명시된 키보다 먼저 적용됩니다.
+// decodeStruct - Decodes a mapping node into a struct. Merge keys ("<<") are applied before explicit keys.
+func (d *yamlDecoder) decodeStruct(n *yaml.Node, rv reflect.Value, path string, depth int) error {
+	if err := d.merge(n, rv, path, depth); err != nil {
+		return err
+	}
+	fields := getYAMLFields(rv.Type())
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, val := n.Content[i], n.Content[i+1]
+		if key.ShortTag() == "!!merge" {
+			continue
+		}
+		f := fields.lookup(key.Value)
+		if f == nil {
+			if d.strict {
+				return BindError{Field: joinPath(path, key.Value), Err: fmt.Errorf("%w %q", ErrUnknownField, key.Value)}
+			}
+			continue
+		}
+		if err := d.decode(val, fieldByIndexAlloc(rv, f.index), joinPath(path, key.Value), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeMap - 매핑 노드를 맵에 디코딩합니다.
+// decodeMap - Decodes a mapping node into a map.
+func (d *yamlDecoder) decodeMap(n *yaml.Node, rv reflect.Value, path string, depth int) error {
+	if rv.IsNil() {
+		rv.Set(reflect.MakeMapWithSize(rv.Type(), len(n.Content)/2))
+	}
+	if err := d.merge(n, rv, path, depth); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, val := n.Content[i], n.Content[i+1]
+		if key.ShortTag() == "!!merge" {
+			continue
+		}
+		kv := reflect.New(rv.Type().Key()).Elem()
+		if err := d.leaf(key, kv, path); err != nil {
+			return err
+		}
+		ev := reflect.New(rv.Type().Elem()).Elem()
+		if existing := rv.MapIndex(kv); existing.IsValid() {
+			ev.Set(existing)
+		}
+		if err := d.decode(val, ev, joinPath(path, key.Value), depth+1); err != nil {
+			return err
+		}
+		rv.SetMapIndex(kv, ev)
+	}
+	return nil
+}
+
+// merge - 매핑 노드의 병합 키가 가리키는 매핑들을 먼저 디코딩합니다.
+// merge - Decodes the mappings referred to by the merge keys of a mapping node first.
+func (d *yamlDecoder) merge(n *yaml.Node, rv reflect.Value, path string, depth int) error {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].ShortTag() != "!!merge" {
+			continue
+		}
+		val := n.Content[i+1]
+		sources := []*yaml.Node{val}
+		if val.Kind == yaml.SequenceNode {
+			sources = val.Content
+		}
+		for _, src := range sources {
+			if err := d.decode(src, rv, path, depth); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeSequence - 시퀀스 노드를 슬라이스 또는 배열에 디코딩합니다.
+// decodeSequence - Decodes a sequence node into a slice or array.
+func (d *yamlDecoder) decodeSequence(n *yaml.Node, rv reflect.Value, path string, depth int) error {
+	if rv.Kind() == reflect.Slice {
+		rv.Set(reflect.MakeSlice(rv.Type(), len(n.Content), len(n.Content)))
+	}
+	for i, item := range n.Content {
+		if i >= rv.Len() {
+			break
+		}
+		if err := d.decode(item, rv.Index(i), path+"["+strconv.Itoa(i)+"]", depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// joinPath - 필드 경로에 키를 덧붙입니다.
+// joinPath - Appends a key to a field path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// measureYAML - 앨리어스를 따라가지 않고 문서의 노드 수와 최대 중첩 깊이를 잽니다.
+// measureYAML - Measures the node count and maximum nesting depth of a document without following aliases.
+func measureYAML(n *yaml.Node, depth int) (count, maxDepth int) {
+	count, maxDepth = 1, depth
+	if n.Kind == yaml.MappingNode || n.Kind == yaml.SequenceNode {
+		depth++
+		maxDepth = depth
+	}
+	for _, c := range n.Content {
+		cc, cd := measureYAML(c, depth)
+		count += cc
+		maxDepth = max(maxDepth, cd)
+	}
+	return count, maxDepth
+}
+
+// yamlField - YAML 키로 찾을 수 있는 구조체 필드
+// yamlField - A struct field reachable by a YAML key.
+type yamlField struct {
+	name  string
+	index []int
+}
+
+// yamlFields - 구조체 타입별 YAML 필드 목록
+// yamlFields - The YAML fields of a struct type.
+type yamlFields []yamlField
+
+// lookup - yaml.v3와 같이 키와 대소문자까지 정확히 일치하는 필드를 찾습니다.
+// lookup - Like yaml.v3, finds the field whose name matches the key exactly, including case.
+func (fs yamlFields) lookup(key string) *yamlField {
+	for i := range fs {
+		if fs[i].name == key {
+			return &fs[i]
+		}
+	}
+	return nil
+}
+
+// yamlFieldCache - 구조체 타입별 YAML 필드 목록 캐시
+// yamlFieldCache - A cache of YAML fields per struct type.
+var yamlFieldCache = &sync.Map{}
+
+// getYAMLFields - 구조체 타입의 YAML 필드 목록을 반환합니다.
+// `yaml:",inline"`이 붙었거나 이름 없이 임베드된 구조체의 필드는 평탄화하며, 직접 선언된 필드가 우선합니다.
+// getYAMLFields - Returns the YAML fields of a struct type.
+// Fields of structs tagged `yaml:",inline"` or embedded without a name are flattened; directly declared fields take precedence.
+func getYAMLFields(rt reflect.Type) yamlFields {
+	if cached, ok := yamlFieldCache.Load(rt); ok {
+		return cached.(yamlFields)
+	}
+	fields := buildYAMLFields(rt, map[reflect.Type]bool{})
+	yamlFieldCache.Store(rt, fields)
+	return fields
+}
+
+// buildYAMLFields - 구조체 타입의 YAML 필드 목록을 만듭니다. seen은 임베드 순환을 막습니다.
+// buildYAMLFields - Builds the YAML fields of a struct type. seen guards against embedding cycles.
+func buildYAMLFields(rt reflect.Type, seen map[reflect.Type]bool) yamlFields {
+	seen[rt] = true
+	defer delete(seen, rt)
+	var fields, inlined yamlFields
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		name, inline, skip := yamlFieldName(sf)
+		if skip {
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if inline && ft.Kind() == reflect.Struct {
+			// 내보내지 않은 임베드 포인터는 할당할 수 없고, 순환하는 임베드는 따라가지 않습니다.
+			if (sf.Type.Kind() == reflect.Ptr && !sf.IsExported()) || seen[ft] {
+				continue
+			}
+			for _, f := range buildYAMLFields(ft, seen) {
+				inlined = append(inlined, yamlField{name: f.name, index: append([]int{i}, f.index...)})
+			}
+			continue
+		}
+		if inline {
+			name = strings.ToLower(sf.Name)
+		}
+		if sf.IsExported() {
+			fields = append(fields, yamlField{name: name, index: []int{i}})
+		}
+	}
+	for _, f := range inlined {
+		if !slices.ContainsFunc(fields, func(g yamlField) bool { return g.name == f.name }) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// yamlFieldName - 필드의 YAML 키 이름을 yaml 태그, json 태그, 소문자 필드 이름 순으로 결정합니다.
+// yamlFieldName - Determines the YAML key of a field from the yaml tag, then the json tag, then the lowercased field name.
+func yamlFieldName(sf reflect.StructField) (name string, inline, skip bool) {
+	if tag, ok := sf.Tag.Lookup("yaml"); ok {
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false, true
+		}
+		for _, o := range strings.Split(opts, ",") {
+			if o == "inline" {
+				return "", true, false
+			}
+		}
+		if name != "" {
+			return name, false, false
+		}
+	} else if tag, ok := sf.Tag.Lookup("json"); ok {
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false, true
+		}
+		if name != "" {
+			return name, false, false
+		}
+	}
+	if sf.Anonymous {
+		// encoding/json과 같이 이름 없이 임베드된 구조체는 평탄화합니다.
+		return "", true, false
+	}
+	return strings.ToLower(sf.Name), false, false
+}